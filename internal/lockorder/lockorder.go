@@ -0,0 +1,43 @@
+// Package lockorder orders mutex acquisition across instances of a type so
+// operations that lock two of them at once cannot deadlock.
+package lockorder
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+var lastID atomic.Uint64
+
+// ID is a process-wide unique ordering key, assigned on first use. The zero
+// value is ready to use and an ID must not be copied after first use.
+type ID struct {
+	v atomic.Uint64
+}
+
+// value returns the key, assigning the next free one on first call.
+func (id *ID) value() uint64 {
+	if v := id.v.Load(); v != 0 {
+		return v
+	}
+	id.v.CompareAndSwap(0, lastID.Add(1))
+	return id.v.Load()
+}
+
+// Lock write-locks a and b, keyed by aID and bID, lowest key first, and
+// returns the matching unlock. When both keys are the same only a is locked.
+func Lock(aID *ID, a *sync.RWMutex, bID *ID, b *sync.RWMutex) func() {
+	if aID == bID {
+		a.Lock()
+		return a.Unlock
+	}
+	if bID.value() < aID.value() {
+		a, b = b, a
+	}
+	a.Lock()
+	b.Lock()
+	return func() {
+		b.Unlock()
+		a.Unlock()
+	}
+}
@@ -0,0 +1,59 @@
+package lockorder
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type guarded struct {
+	order ID
+	mu    sync.RWMutex
+	n     int
+}
+
+func TestIDIsStableAndUnique(t *testing.T) {
+	var a, b ID
+	assert.NotZero(t, a.value())
+	assert.Equal(t, a.value(), a.value())
+	assert.NotEqual(t, a.value(), b.value())
+}
+
+func TestLockSameInstance(t *testing.T) {
+	var g guarded
+	unlock := Lock(&g.order, &g.mu, &g.order, &g.mu)
+	g.n++
+	unlock()
+
+	// The mutex is free again
+	assert.True(t, g.mu.TryLock())
+	g.mu.Unlock()
+}
+
+func TestLockOppositeDirections(t *testing.T) {
+	var a, b guarded
+	var wg sync.WaitGroup
+
+	// Locking in both argument orders at once must not deadlock
+	for range 100 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			unlock := Lock(&a.order, &a.mu, &b.order, &b.mu)
+			a.n++
+			b.n++
+			unlock()
+		}()
+		go func() {
+			defer wg.Done()
+			unlock := Lock(&b.order, &b.mu, &a.order, &a.mu)
+			a.n++
+			b.n++
+			unlock()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 200, a.n)
+	assert.Equal(t, 200, b.n)
+}
@@ -0,0 +1,29 @@
+// Package signal provides the broadcast wake-up helper shared by the
+// blocking queue and priority queue types.
+package signal
+
+// Signal is a broadcast notification for goroutines waiting on a condition
+// guarded by an external mutex. Both methods must be called with that mutex
+// held; only the channel returned by Wait is read without it. The zero
+// value is ready to use.
+type Signal struct {
+	ch chan struct{}
+}
+
+// Wait returns a channel that is closed on the next broadcast.
+func (s *Signal) Wait() <-chan struct{} {
+	if s.ch == nil {
+		s.ch = make(chan struct{})
+	}
+	return s.ch
+}
+
+// Broadcast wakes every goroutine waiting on the current channel. It does
+// nothing when no one has called Wait since the last broadcast.
+func (s *Signal) Broadcast() {
+	if s.ch == nil {
+		return
+	}
+	close(s.ch)
+	s.ch = nil
+}
@@ -0,0 +1,34 @@
+package signal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignal(t *testing.T) {
+	var s Signal
+
+	// Broadcast without waiters is a no-op
+	s.Broadcast()
+
+	first := s.Wait()
+	assert.Equal(t, first, s.Wait(), "waiters share the channel until a broadcast")
+	select {
+	case <-first:
+		t.Fatal("channel closed before broadcast")
+	default:
+	}
+
+	s.Broadcast()
+	_, open := <-first
+	assert.False(t, open)
+
+	second := s.Wait()
+	assert.NotEqual(t, first, second)
+	select {
+	case <-second:
+		t.Fatal("new channel should be open")
+	default:
+	}
+}
@@ -0,0 +1,105 @@
+package list
+
+import "iter"
+
+// All returns a weakly consistent iterator over index-element pairs from
+// head to tail. It survives removal of the yielded node and ends early only
+// if both that node and its successor leave the list.
+func (list *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var previous, current *Node[T]
+		for index := 0; ; index++ {
+			node, element, next, ok := list.step(previous, current, true)
+			if !ok || !yield(index, element) {
+				return
+			}
+			previous, current = node, next
+		}
+	}
+}
+
+// Backward returns an iterator over index-element pairs from tail to head
+// with the same guarantees as All. Indices count down from the length
+// observed when iteration starts, as in IterateBackward.
+func (list *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		list.mu.RLock()
+		index := list.size - 1
+		list.mu.RUnlock()
+
+		var previous, current *Node[T]
+		for ; ; index-- {
+			node, element, prev, ok := list.step(previous, current, false)
+			if !ok || !yield(index, element) {
+				return
+			}
+			previous, current = node, prev
+		}
+	}
+}
+
+// Values returns an iterator over the elements from head to tail with the
+// same guarantees as All.
+func (list *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, element := range list.All() {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns the elements from head to tail, copied under a single
+// read lock so the result reflects the list at one instant.
+func (list *List[T]) Snapshot() []T {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	elements := make([]T, 0, list.size)
+	for current := list.head; current != nil; current = current.Next() {
+		elements = append(elements, current.Element())
+	}
+	return elements
+}
+
+// SnapshotAll returns an iterator over index-element pairs of a Snapshot
+// taken when iteration starts. Indices are exact positions at that
+// instant, and the loop body may modify the list freely.
+func (list *List[T]) SnapshotAll() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for index, element := range list.Snapshot() {
+			if !yield(index, element) {
+				return
+			}
+		}
+	}
+}
+
+// step reads node together with its neighbour in the walking direction
+// under the read lock. The first step (previous == nil) starts from the
+// live head or tail. If node has left the list it resumes from the live
+// neighbour of previous, the node yielded last, reporting false when
+// neither is still linked.
+func (list *List[T]) step(previous, node *Node[T], forward bool) (*Node[T], T, *Node[T], bool) {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	neighbour := (*Node[T]).Prev
+	if forward {
+		neighbour = (*Node[T]).Next
+	}
+	if previous == nil {
+		node = list.tail
+		if forward {
+			node = list.head
+		}
+	} else if !list.owns(node) && list.owns(previous) {
+		node = neighbour(previous)
+	}
+	if !list.owns(node) {
+		var zero T
+		return nil, zero, nil, false
+	}
+	return node, node.Element(), neighbour(node), true
+}
@@ -0,0 +1,290 @@
+package list
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAll(t *testing.T) {
+	l := NewList[int]()
+	for range l.All() {
+		t.Fatal("empty list should not yield")
+	}
+
+	l.PushBack(10)
+	l.PushBack(20)
+	l.PushBack(30)
+
+	var indices, elements []int
+	for i, v := range l.All() {
+		indices = append(indices, i)
+		elements = append(elements, v)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{10, 20, 30}, elements)
+
+	// Early break
+	elements = nil
+	for _, v := range l.All() {
+		if v == 20 {
+			break
+		}
+		elements = append(elements, v)
+	}
+	assert.Equal(t, []int{10}, elements)
+}
+
+func TestBackward(t *testing.T) {
+	l := NewList[int]()
+	for range l.Backward() {
+		t.Fatal("empty list should not yield")
+	}
+
+	l.PushBack(10)
+	l.PushBack(20)
+	l.PushBack(30)
+
+	var indices, elements []int
+	for i, v := range l.Backward() {
+		indices = append(indices, i)
+		elements = append(elements, v)
+	}
+	assert.Equal(t, []int{2, 1, 0}, indices)
+	assert.Equal(t, []int{30, 20, 10}, elements)
+
+	// Early break
+	elements = nil
+	for _, v := range l.Backward() {
+		elements = append(elements, v)
+		break
+	}
+	assert.Equal(t, []int{30}, elements)
+}
+
+func TestValues(t *testing.T) {
+	l := NewList[string]()
+	assert.Empty(t, slices.Collect(l.Values()))
+
+	l.PushBack("a")
+	l.PushBack("b")
+	l.PushFront("z")
+	assert.Equal(t, []string{"z", "a", "b"}, slices.Collect(l.Values()))
+
+	// Early break
+	var got []string
+	for v := range l.Values() {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []string{"z", "a"}, got)
+}
+
+func TestSnapshot(t *testing.T) {
+	l := NewList[int]()
+	assert.Empty(t, l.Snapshot())
+
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+	snapshot := l.Snapshot()
+	assert.Equal(t, []int{1, 2, 3}, snapshot)
+
+	// The snapshot is a copy
+	snapshot[0] = 9
+	l.PushBack(4)
+	assert.Equal(t, []int{1, 2, 3, 4}, l.Snapshot())
+}
+
+func TestSnapshotAll(t *testing.T) {
+	l := NewList[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	// Mutating the list inside the loop does not affect the iteration
+	var indices, elements []int
+	for i, v := range l.SnapshotAll() {
+		indices = append(indices, i)
+		elements = append(elements, v)
+		l.PopFront()
+		l.PushBack(v * 10)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, elements)
+	assert.Equal(t, []int{10, 20, 30}, l.Snapshot())
+
+	elements = nil
+	for _, v := range l.SnapshotAll() {
+		elements = append(elements, v)
+		break
+	}
+	assert.Equal(t, []int{10}, elements)
+}
+
+func TestAllContinuesWhenCurrentNodeRemoved(t *testing.T) {
+	l := NewList[int]()
+	l.PushBack(1)
+	second := l.PushBack(2)
+	l.PushBack(3)
+
+	var elements []int
+	for _, v := range l.All() {
+		elements = append(elements, v)
+		if v == 2 {
+			_, err := l.Remove(second)
+			assert.NoError(t, err)
+		}
+	}
+	assert.Equal(t, []int{1, 2, 3}, elements)
+	assert.Equal(t, []int{1, 3}, l.Snapshot())
+
+	// Removing the captured successor resumes from the current node
+	elements = nil
+	for _, v := range l.All() {
+		elements = append(elements, v)
+		if v == 1 {
+			l.PopBack()
+			l.PushBack(4)
+		}
+	}
+	assert.Equal(t, []int{1, 4}, elements)
+
+	elements = nil
+	for _, v := range l.Backward() {
+		elements = append(elements, v)
+		l.PopBack()
+	}
+	assert.Equal(t, []int{4, 1}, elements)
+	assert.Equal(t, 0, l.Len())
+}
+
+func TestIterateForwardPopFront(t *testing.T) {
+	l := newIntList(0, 1, 2, 3, 4)
+
+	var visited []int
+	l.IterateForward(func(_ int, v int) {
+		visited = append(visited, v)
+		l.PopFront()
+	})
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, visited)
+	assert.Equal(t, 0, l.Len())
+}
+
+func TestSnapshotConcurrentAccess(t *testing.T) {
+	l := NewList[int]()
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	// The writer keeps the list a run of consecutive integers
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(done)
+		for i := range 2000 {
+			l.PushBack(i)
+			if l.Len() > 8 {
+				l.PopFront()
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			wg.Wait()
+			return
+		default:
+		}
+		snapshot := l.Snapshot()
+		for i := 1; i < len(snapshot); i++ {
+			require.Equal(t, snapshot[i-1]+1, snapshot[i], "snapshot %v", snapshot)
+		}
+	}
+}
+
+func TestLiveIterationConcurrentAccess(t *testing.T) {
+	l := NewList[int]()
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	// Values only enter at the back and leave at the front, so any
+	// weakly consistent forward walk sees them strictly increasing.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(done)
+		for i := range 2000 {
+			l.PushBack(i)
+			if l.Len() > 8 {
+				l.PopFront()
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			wg.Wait()
+			return
+		default:
+		}
+		last := -1
+		for i, v := range l.All() {
+			require.Greater(t, v, last)
+			require.GreaterOrEqual(t, i, 0)
+			last = v
+		}
+		last = -1
+		l.IterateForward(func(_ int, v int) {
+			assert.Greater(t, v, last)
+			last = v
+		})
+	}
+}
+
+func TestLiveIterationSeesStableElements(t *testing.T) {
+	l := NewList[int]()
+	for i := range 500 {
+		l.PushBack(i)
+	}
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	// Writers add and remove only elements outside the original range
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			node := l.PushBack(1000 + i)
+			l.PushFront(-1 - i)
+			_, _ = l.Remove(node)
+			l.PopFront()
+		}
+	}()
+
+	for range 20 {
+		var originals []int
+		for _, v := range l.All() {
+			if v >= 0 && v < 500 {
+				originals = append(originals, v)
+			}
+		}
+		require.Len(t, originals, 500)
+		for i, v := range originals {
+			require.Equal(t, i, v)
+		}
+	}
+	close(done)
+	wg.Wait()
+}
@@ -1,89 +1,108 @@
+// Package list provides a concurrency-safe generic doubly linked list.
+//
+// Iteration offers two consistency levels. Snapshot and SnapshotAll copy
+// the elements under a single read lock, so they reflect the list at one
+// instant and the loop body may modify the list freely.
+//
+// All, Backward, Values, IterateForward and IterateBackward walk the live
+// list and are weakly consistent. Each step reads a node together with its
+// neighbour under the read lock, so a concurrent mutation is never observed
+// half-applied, and removing the yielded element, even from the loop body,
+// does not end the walk. The walk ends early only if both the captured
+// neighbour and the node last yielded have left the list; otherwise
+// elements that stay in the list and in place for the whole iteration are
+// yielded exactly once, in order, while elements added or removed meanwhile
+// may or may not be seen.
 package list
 
 import (
 	"errors"
 	"sync"
+
+	"github.com/ckshitij/collection/internal/lockorder"
 )
 
 type List[T any] struct {
-	head *Node[T]
-	tail *Node[T]
-	size int
-	mu   sync.RWMutex
+	head  *Node[T]
+	tail  *Node[T]
+	size  int
+	order lockorder.ID // orders locking in cross-list operations
+	mu    sync.RWMutex
 }
 
 var (
-	ErrInvalidPosition  = errors.New("invalid position, please check the list size")
-	ErrNegativePosition = errors.New("position must be non-negative")
-	ErrOutOfBound       = errors.New("position out of bounds")
+	ErrInvalidPosition        = errors.New("invalid position, please check the list size")
+	ErrNegativePosition error = &positionError{"position must be non-negative"}
+	ErrOutOfBound       error = &positionError{"position out of bounds"}
+	ErrNodeNotInList          = errors.New("node does not belong to this list")
 )
 
+// positionError is a specific position error that also matches
+// ErrInvalidPosition under errors.Is.
+type positionError struct {
+	msg string
+}
+
+func (e *positionError) Error() string {
+	return e.msg
+}
+
+func (e *positionError) Is(target error) bool {
+	return target == ErrInvalidPosition
+}
+
 func NewList[T any]() *List[T] {
 	return &List[T]{}
 }
 
-func (list *List[T]) PushFront(element T) {
+// PushFront inserts element at the head of the list and returns its node.
+func (list *List[T]) PushFront(element T) *Node[T] {
 	list.mu.Lock()
 	defer list.mu.Unlock()
 
 	newNode := NewNode(element)
-	if list.head != nil {
-		newNode.setNext(list.head)
-		list.head.setPrev(newNode)
-	} else {
-		list.tail = newNode
-	}
-	list.head = newNode
-	list.size++
+	list.pushFrontNode(newNode)
+	return newNode
 }
 
-func (list *List[T]) PushBack(element T) {
+// PushBack inserts element at the tail of the list and returns its node.
+func (list *List[T]) PushBack(element T) *Node[T] {
 	list.mu.Lock()
 	defer list.mu.Unlock()
 
 	newNode := NewNode(element)
-	if list.tail != nil {
-		newNode.setPrev(list.tail)
-		list.tail.setNext(newNode)
-	} else {
-		list.head = newNode
-	}
-	list.tail = newNode
-	list.size++
+	list.pushBackNode(newNode)
+	return newNode
 }
 
-func (list *List[T]) PopFront() {
+// PopFront removes the head of the list and returns its element.
+// The boolean is false if the list was empty.
+func (list *List[T]) PopFront() (T, bool) {
 	list.mu.Lock()
 	defer list.mu.Unlock()
 
+	var zero T
 	if list.head == nil {
-		return
-	}
-	next := list.head.Next()
-	list.head = next
-	if list.head == nil {
-		list.tail = nil
-	} else {
-		list.head.setPrev(nil)
+		return zero, false
 	}
-	list.size--
+	node := list.head
+	list.unlink(node)
+	return node.Element(), true
 }
 
-func (list *List[T]) PopBack() {
+// PopBack removes the tail of the list and returns its element.
+// The boolean is false if the list was empty.
+func (list *List[T]) PopBack() (T, bool) {
 	list.mu.Lock()
 	defer list.mu.Unlock()
 
+	var zero T
 	if list.tail == nil {
-		return
-	}
-	prev := list.tail.Prev()
-	list.tail = prev
-	if list.tail == nil {
-		list.head = nil
-	} else {
-		list.tail.setNext(nil)
+		return zero, false
 	}
-	list.size--
+	node := list.tail
+	list.unlink(node)
+	return node.Element(), true
 }
 
 func (list *List[T]) Front() *Node[T] {
@@ -104,6 +123,8 @@ func (list *List[T]) Len() int {
 	return list.size
 }
 
+// InsertAtPosition inserts data so that it ends up at position, walking
+// from whichever end is closer. Inserting at Len() appends.
 func (list *List[T]) InsertAtPosition(data T, position int) error {
 	list.mu.Lock()
 	defer list.mu.Unlock()
@@ -111,67 +132,192 @@ func (list *List[T]) InsertAtPosition(data T, position int) error {
 	if position < 0 {
 		return ErrNegativePosition
 	}
+	if position > list.size {
+		return ErrOutOfBound
+	}
 
 	newNode := NewNode(data)
-	if position == 0 {
-		list.pushFrontNode(newNode)
+	if position == list.size {
+		list.pushBackNode(newNode)
 		return nil
 	}
 
-	current := list.head
-	for i := 0; i < position-1; i++ {
-		if current == nil {
-			return ErrOutOfBound
-		}
-		current = current.Next()
+	mark, err := list.nodeAt(position)
+	if err != nil {
+		return err
 	}
+	list.insertBeforeNode(newNode, mark)
+	return nil
+}
 
-	if current == nil || current.Next() == nil {
-		list.pushBackNode(newNode)
-	} else {
-		next := current.Next()
-		newNode.setNext(next)
-		newNode.setPrev(current)
-		next.setPrev(newNode)
-		current.setNext(newNode)
-		list.size++
+// Remove unlinks node from the list in O(1) and returns its element.
+// It returns ErrNodeNotInList if node is nil or belongs to another list.
+func (list *List[T]) Remove(node *Node[T]) (T, error) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	var zero T
+	if !list.owns(node) {
+		return zero, ErrNodeNotInList
+	}
+	list.unlink(node)
+	return node.Element(), nil
+}
+
+// InsertBefore inserts element immediately before mark and returns its node.
+func (list *List[T]) InsertBefore(element T, mark *Node[T]) (*Node[T], error) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	if !list.owns(mark) {
+		return nil, ErrNodeNotInList
+	}
+	newNode := NewNode(element)
+	list.insertBeforeNode(newNode, mark)
+	return newNode, nil
+}
+
+// InsertAfter inserts element immediately after mark and returns its node.
+func (list *List[T]) InsertAfter(element T, mark *Node[T]) (*Node[T], error) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	if !list.owns(mark) {
+		return nil, ErrNodeNotInList
+	}
+	newNode := NewNode(element)
+	list.insertAfterNode(newNode, mark)
+	return newNode, nil
+}
+
+// MoveToFront moves node to the head of the list.
+func (list *List[T]) MoveToFront(node *Node[T]) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	if !list.owns(node) {
+		return ErrNodeNotInList
 	}
+	if list.head == node {
+		return nil
+	}
+	list.unlink(node)
+	list.pushFrontNode(node)
 	return nil
 }
 
-func (list *List[T]) IterateForward(action func(index int, element T)) {
+// MoveToBack moves node to the tail of the list.
+func (list *List[T]) MoveToBack(node *Node[T]) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	if !list.owns(node) {
+		return ErrNodeNotInList
+	}
+	if list.tail == node {
+		return nil
+	}
+	list.unlink(node)
+	list.pushBackNode(node)
+	return nil
+}
+
+// MoveBefore moves node to the position immediately before mark.
+// Moving a node relative to itself is a no-op.
+func (list *List[T]) MoveBefore(node, mark *Node[T]) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	if !list.owns(node) || !list.owns(mark) {
+		return ErrNodeNotInList
+	}
+	if node == mark {
+		return nil
+	}
+	list.unlink(node)
+	list.insertBeforeNode(node, mark)
+	return nil
+}
+
+// MoveAfter moves node to the position immediately after mark.
+// Moving a node relative to itself is a no-op.
+func (list *List[T]) MoveAfter(node, mark *Node[T]) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	if !list.owns(node) || !list.owns(mark) {
+		return ErrNodeNotInList
+	}
+	if node == mark {
+		return nil
+	}
+	list.unlink(node)
+	list.insertAfterNode(node, mark)
+	return nil
+}
+
+// At returns the element at position, walking from whichever end is closer.
+func (list *List[T]) At(position int) (T, error) {
 	list.mu.RLock()
-	current := list.head
-	index := 0
-	list.mu.RUnlock()
+	defer list.mu.RUnlock()
+
+	var zero T
+	node, err := list.nodeAt(position)
+	if err != nil {
+		return zero, err
+	}
+	return node.Element(), nil
+}
+
+// Set replaces the element at position.
+func (list *List[T]) Set(position int, element T) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	node, err := list.nodeAt(position)
+	if err != nil {
+		return err
+	}
+	node.setElement(element)
+	return nil
+}
 
-	for current != nil {
-		element := current.Element()
-		next := current.Next()
+// RemoveAtPosition unlinks the node at position and returns its element.
+func (list *List[T]) RemoveAtPosition(position int) (T, error) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	var zero T
+	node, err := list.nodeAt(position)
+	if err != nil {
+		return zero, err
+	}
+	list.unlink(node)
+	return node.Element(), nil
+}
+
+// IterateForward calls action for each element from head to tail. It
+// shares the weakly consistent guarantees of All.
+func (list *List[T]) IterateForward(action func(index int, element T)) {
+	for index, element := range list.All() {
 		action(index, element)
-		current = next
-		index++
 	}
 }
 
+// IterateBackward calls action for each element from tail to head. It
+// shares the weakly consistent guarantees of Backward.
 func (list *List[T]) IterateBackward(action func(index int, element T)) {
-	list.mu.RLock()
-	current := list.tail
-	index := list.size - 1
-	list.mu.RUnlock()
-
-	for current != nil {
-		element := current.Element()
-		prev := current.Prev()
+	for index, element := range list.Backward() {
 		action(index, element)
-		current = prev
-		index--
 	}
 }
 
 func (list *List[T]) Clear() {
 	list.mu.Lock()
 	defer list.mu.Unlock()
+	for current := list.head; current != nil; current = current.Next() {
+		current.setOwner(nil)
+	}
 	list.head = nil
 	list.tail = nil
 	list.size = 0
@@ -187,6 +333,7 @@ func (list *List[T]) pushFrontNode(node *Node[T]) {
 		list.tail = node
 	}
 	list.head = node
+	node.setOwner(list)
 	list.size++
 }
 
@@ -198,5 +345,80 @@ func (list *List[T]) pushBackNode(node *Node[T]) {
 		list.head = node
 	}
 	list.tail = node
+	node.setOwner(list)
+	list.size++
+}
+
+func (list *List[T]) insertBeforeNode(node, mark *Node[T]) {
+	prev := mark.Prev()
+	node.setPrev(prev)
+	node.setNext(mark)
+	mark.setPrev(node)
+	if prev != nil {
+		prev.setNext(node)
+	} else {
+		list.head = node
+	}
+	node.setOwner(list)
 	list.size++
 }
+
+func (list *List[T]) insertAfterNode(node, mark *Node[T]) {
+	next := mark.Next()
+	node.setPrev(mark)
+	node.setNext(next)
+	mark.setNext(node)
+	if next != nil {
+		next.setPrev(node)
+	} else {
+		list.tail = node
+	}
+	node.setOwner(list)
+	list.size++
+}
+
+// unlink detaches node from its neighbours and clears its ownership.
+func (list *List[T]) unlink(node *Node[T]) {
+	prev, next := node.Prev(), node.Next()
+	if prev != nil {
+		prev.setNext(next)
+	} else {
+		list.head = next
+	}
+	if next != nil {
+		next.setPrev(prev)
+	} else {
+		list.tail = prev
+	}
+	node.setPrev(nil)
+	node.setNext(nil)
+	node.setOwner(nil)
+	list.size--
+}
+
+// nodeAt returns the node at position, walking from the head or the tail,
+// whichever is closer. Errors match ErrInvalidPosition.
+func (list *List[T]) nodeAt(position int) (*Node[T], error) {
+	if position < 0 {
+		return nil, ErrNegativePosition
+	}
+	if position >= list.size {
+		return nil, ErrOutOfBound
+	}
+	if position < list.size/2 {
+		current := list.head
+		for range position {
+			current = current.Next()
+		}
+		return current, nil
+	}
+	current := list.tail
+	for range list.size - 1 - position {
+		current = current.Prev()
+	}
+	return current, nil
+}
+
+func (list *List[T]) owns(node *Node[T]) bool {
+	return node != nil && node.owner() == list
+}
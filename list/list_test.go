@@ -1,6 +1,7 @@
 package list
 
 import (
+	"reflect"
 	"sync"
 	"testing"
 
@@ -48,7 +49,9 @@ func TestAppend(t *testing.T) {
 
 func TestPopFront(t *testing.T) {
 	l := NewList[int]()
-	l.PopFront() // No-op
+	v, ok := l.PopFront() // No-op
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
 	assert.Equal(t, (0), l.Len())
 
 	l.PushFront(10)
@@ -59,14 +62,18 @@ func TestPopFront(t *testing.T) {
 
 	l.PushFront(10)
 	l.PushFront(20)
-	l.PopFront()
+	v, ok = l.PopFront()
+	assert.True(t, ok)
+	assert.Equal(t, 20, v)
 	assert.Equal(t, (1), l.Len())
 	assert.Equal(t, 10, l.head.Element())
 }
 
 func TestPopBack(t *testing.T) {
 	l := NewList[int]()
-	l.PopBack() // No-op
+	v, ok := l.PopBack() // No-op
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
 	assert.Equal(t, (0), l.Len())
 
 	l.PushFront(10)
@@ -77,7 +84,9 @@ func TestPopBack(t *testing.T) {
 
 	l.PushFront(10)
 	l.PushFront(20)
-	l.PopBack()
+	v, ok = l.PopBack()
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
 	assert.Equal(t, (1), l.Len())
 	assert.Equal(t, 20, l.head.Element())
 }
@@ -135,6 +144,23 @@ func TestInsertAtPosition(t *testing.T) {
 	assert.Equal(t, 25, l.head.Next().Element())
 }
 
+func TestInsertAtPositionBounds(t *testing.T) {
+	l := newIntList(1, 2, 3)
+
+	// Len()+1 is out of range like At, Set and RemoveAtPosition
+	assert.Equal(t, ErrOutOfBound, l.InsertAtPosition(9, l.Len()+1))
+	_, err := l.At(3)
+	assert.Equal(t, ErrOutOfBound, err)
+	assert.Equal(t, []int{1, 2, 3}, listValues(l))
+
+	require.NoError(t, l.InsertAtPosition(4, l.Len()))
+	require.NoError(t, l.InsertAtPosition(0, 0))
+	require.NoError(t, l.InsertAtPosition(35, 4))
+	require.NoError(t, l.InsertAtPosition(15, 2))
+	assert.Equal(t, []int{0, 1, 15, 2, 3, 35, 4}, listValues(l))
+	assertLinks(t, l)
+}
+
 func TestIterateForward(t *testing.T) {
 	list := NewList[int]()
 	results := []struct {
@@ -324,3 +350,225 @@ func TestConcurrentAccess(t *testing.T) {
 		_ = tail.Element()
 	}
 }
+
+func listValues[T any](l *List[T]) []T {
+	values := []T{}
+	l.IterateForward(func(_ int, element T) {
+		values = append(values, element)
+	})
+	return values
+}
+
+func TestPushReturnsNode(t *testing.T) {
+	l := NewList[int]()
+	front := l.PushFront(1)
+	back := l.PushBack(2)
+	assert.Equal(t, l.Front(), front)
+	assert.Equal(t, l.Back(), back)
+}
+
+func TestRemove(t *testing.T) {
+	l := NewList[int]()
+	n1 := l.PushBack(1)
+	n2 := l.PushBack(2)
+	n3 := l.PushBack(3)
+
+	v, err := l.Remove(n2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, []int{1, 3}, listValues(l))
+	assert.Nil(t, n2.Next())
+	assert.Nil(t, n2.Prev())
+
+	// Removing twice fails
+	_, err = l.Remove(n2)
+	assert.Equal(t, ErrNodeNotInList, err)
+
+	_, err = l.Remove(n1)
+	require.NoError(t, err)
+	assert.Equal(t, n3, l.Front())
+	_, err = l.Remove(n3)
+	require.NoError(t, err)
+	assert.Equal(t, 0, l.Len())
+	assert.Nil(t, l.Front())
+	assert.Nil(t, l.Back())
+
+	_, err = l.Remove(nil)
+	assert.Equal(t, ErrNodeNotInList, err)
+	_, err = l.Remove(NewNode(5))
+	assert.Equal(t, ErrNodeNotInList, err)
+}
+
+func TestRemoveForeignNode(t *testing.T) {
+	a := NewList[int]()
+	b := NewList[int]()
+	n := a.PushBack(1)
+	b.PushBack(1)
+
+	_, err := b.Remove(n)
+	assert.Equal(t, ErrNodeNotInList, err)
+	assert.Equal(t, 1, a.Len())
+	assert.Equal(t, 1, b.Len())
+
+	// Popped and cleared nodes no longer belong to the list
+	a.PopFront()
+	_, err = a.Remove(n)
+	assert.Equal(t, ErrNodeNotInList, err)
+
+	n = b.Front()
+	b.Clear()
+	_, err = b.Remove(n)
+	assert.Equal(t, ErrNodeNotInList, err)
+}
+
+func TestInsertBeforeAfter(t *testing.T) {
+	l := NewList[int]()
+	n2 := l.PushBack(2)
+
+	n1, err := l.InsertBefore(1, n2)
+	require.NoError(t, err)
+	assert.Equal(t, n1, l.Front())
+
+	n4, err := l.InsertAfter(4, n2)
+	require.NoError(t, err)
+	assert.Equal(t, n4, l.Back())
+
+	_, err = l.InsertAfter(3, n2)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, listValues(l))
+	assert.Equal(t, 4, l.Len())
+
+	_, err = l.InsertBefore(0, NewNode(9))
+	assert.Equal(t, ErrNodeNotInList, err)
+	_, err = l.InsertAfter(0, nil)
+	assert.Equal(t, ErrNodeNotInList, err)
+	assert.Equal(t, 4, l.Len())
+}
+
+func TestMoveToFrontBack(t *testing.T) {
+	l := NewList[int]()
+	n1 := l.PushBack(1)
+	n2 := l.PushBack(2)
+	n3 := l.PushBack(3)
+
+	require.NoError(t, l.MoveToFront(n3))
+	assert.Equal(t, []int{3, 1, 2}, listValues(l))
+	require.NoError(t, l.MoveToFront(n3))
+	assert.Equal(t, []int{3, 1, 2}, listValues(l))
+
+	require.NoError(t, l.MoveToBack(n3))
+	assert.Equal(t, []int{1, 2, 3}, listValues(l))
+	require.NoError(t, l.MoveToBack(n1))
+	assert.Equal(t, []int{2, 3, 1}, listValues(l))
+	assert.Equal(t, n2, l.Front())
+	assert.Equal(t, n1, l.Back())
+	assert.Equal(t, 3, l.Len())
+
+	assert.Equal(t, ErrNodeNotInList, l.MoveToFront(NewNode(0)))
+	assert.Equal(t, ErrNodeNotInList, l.MoveToBack(nil))
+}
+
+func TestMoveBeforeAfter(t *testing.T) {
+	l := NewList[int]()
+	n1 := l.PushBack(1)
+	n2 := l.PushBack(2)
+	n3 := l.PushBack(3)
+
+	require.NoError(t, l.MoveBefore(n3, n1))
+	assert.Equal(t, []int{3, 1, 2}, listValues(l))
+
+	require.NoError(t, l.MoveAfter(n3, n2))
+	assert.Equal(t, []int{1, 2, 3}, listValues(l))
+
+	require.NoError(t, l.MoveAfter(n1, n2))
+	assert.Equal(t, []int{2, 1, 3}, listValues(l))
+
+	require.NoError(t, l.MoveBefore(n2, n2))
+	assert.Equal(t, []int{2, 1, 3}, listValues(l))
+	assert.Equal(t, 3, l.Len())
+
+	other := NewList[int]()
+	foreign := other.PushBack(9)
+	assert.Equal(t, ErrNodeNotInList, l.MoveBefore(foreign, n1))
+	assert.Equal(t, ErrNodeNotInList, l.MoveAfter(n1, foreign))
+	assert.Equal(t, []int{9}, listValues(other))
+}
+
+func TestAt(t *testing.T) {
+	l := NewList[int]()
+	_, err := l.At(0)
+	assert.Equal(t, ErrOutOfBound, err)
+
+	for i := range 7 {
+		l.PushBack(i * 10)
+	}
+	for i := range 7 {
+		v, err := l.At(i)
+		require.NoError(t, err)
+		assert.Equal(t, i*10, v)
+	}
+
+	_, err = l.At(-1)
+	assert.Equal(t, ErrNegativePosition, err)
+	_, err = l.At(7)
+	assert.Equal(t, ErrOutOfBound, err)
+}
+
+func TestSet(t *testing.T) {
+	l := NewList[string]()
+	assert.Equal(t, ErrOutOfBound, l.Set(0, "x"))
+
+	l.PushBack("a")
+	l.PushBack("b")
+	l.PushBack("c")
+	require.NoError(t, l.Set(0, "A"))
+	require.NoError(t, l.Set(2, "C"))
+	assert.Equal(t, []string{"A", "b", "C"}, listValues(l))
+	assert.Equal(t, 3, l.Len())
+
+	assert.Equal(t, ErrNegativePosition, l.Set(-1, "x"))
+	assert.Equal(t, ErrOutOfBound, l.Set(3, "x"))
+}
+
+func TestRemoveAtPosition(t *testing.T) {
+	l := NewList[int]()
+	for i := range 6 {
+		l.PushBack(i)
+	}
+
+	v, err := l.RemoveAtPosition(4)
+	require.NoError(t, err)
+	assert.Equal(t, 4, v)
+	v, err = l.RemoveAtPosition(1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	v, err = l.RemoveAtPosition(0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, v)
+	v, err = l.RemoveAtPosition(l.Len() - 1)
+	require.NoError(t, err)
+	assert.Equal(t, 5, v)
+	assert.Equal(t, []int{2, 3}, listValues(l))
+
+	_, err = l.RemoveAtPosition(-3)
+	assert.Equal(t, ErrNegativePosition, err)
+	_, err = l.RemoveAtPosition(2)
+	assert.Equal(t, ErrOutOfBound, err)
+	assert.Equal(t, 2, l.Len())
+}
+
+func TestPositionErrors(t *testing.T) {
+	assert.ErrorIs(t, ErrNegativePosition, ErrInvalidPosition)
+	assert.ErrorIs(t, ErrOutOfBound, ErrInvalidPosition)
+	assert.NotErrorIs(t, ErrNodeNotInList, ErrInvalidPosition)
+
+	// The exported sentinels keep the plain error type
+	errorType := reflect.TypeFor[error]()
+	assert.Equal(t, errorType, reflect.TypeOf(&ErrNegativePosition).Elem())
+	assert.Equal(t, errorType, reflect.TypeOf(&ErrOutOfBound).Elem())
+
+	l := NewList[int]()
+	err := l.InsertAtPosition(1, -1)
+	assert.ErrorIs(t, err, ErrInvalidPosition)
+	assert.EqualError(t, err, "position must be non-negative")
+}
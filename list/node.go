@@ -7,6 +7,7 @@ type Node[T any] struct {
 	element T
 	prev    *Node[T]
 	next    *Node[T]
+	list    *List[T]
 	mu      sync.RWMutex
 }
 
@@ -34,6 +35,12 @@ func (n *Node[T]) Prev() *Node[T] {
 }
 
 // internal helpers for safe mutation (called only under list lock)
+func (n *Node[T]) setElement(element T) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.element = element
+}
+
 func (n *Node[T]) setNext(next *Node[T]) {
 	n.mu.Lock()
 	defer n.mu.Unlock()
@@ -45,3 +52,16 @@ func (n *Node[T]) setPrev(prev *Node[T]) {
 	defer n.mu.Unlock()
 	n.prev = prev
 }
+
+// owner reports the list the node is currently linked into, or nil.
+func (n *Node[T]) owner() *List[T] {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.list
+}
+
+func (n *Node[T]) setOwner(list *List[T]) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.list = list
+}
@@ -0,0 +1,80 @@
+package list
+
+// The predicate-based methods below run entirely under the list lock so the
+// result reflects a single consistent state. Predicates must not call back
+// into the same list.
+
+// FindFunc returns the first node from the head whose element satisfies
+// match, or nil if there is none.
+func (list *List[T]) FindFunc(match func(T) bool) *Node[T] {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	node, _ := list.find(match)
+	return node
+}
+
+// IndexFunc returns the position of the first element satisfying match,
+// or -1 if there is none.
+func (list *List[T]) IndexFunc(match func(T) bool) int {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	_, index := list.find(match)
+	return index
+}
+
+// ContainsFunc reports whether at least one element satisfies match.
+func (list *List[T]) ContainsFunc(match func(T) bool) bool {
+	return list.IndexFunc(match) >= 0
+}
+
+// DeleteFunc removes every element satisfying del and returns how many
+// were removed.
+func (list *List[T]) DeleteFunc(del func(T) bool) int {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	removed := 0
+	for current := list.head; current != nil; {
+		next := current.Next()
+		if del(current.Element()) {
+			list.unlink(current)
+			removed++
+		}
+		current = next
+	}
+	return removed
+}
+
+// Find returns the first node holding value, or nil if there is none.
+func Find[T comparable](list *List[T], value T) *Node[T] {
+	return list.FindFunc(func(element T) bool { return element == value })
+}
+
+// Index returns the position of the first occurrence of value, or -1.
+func Index[T comparable](list *List[T], value T) int {
+	return list.IndexFunc(func(element T) bool { return element == value })
+}
+
+// Contains reports whether value is present in the list.
+func Contains[T comparable](list *List[T], value T) bool {
+	return Index(list, value) >= 0
+}
+
+// RemoveAll removes every occurrence of value and returns how many were removed.
+func RemoveAll[T comparable](list *List[T], value T) int {
+	return list.DeleteFunc(func(element T) bool { return element == value })
+}
+
+// find must be called under list.mu.
+func (list *List[T]) find(match func(T) bool) (*Node[T], int) {
+	index := 0
+	for current := list.head; current != nil; current = current.Next() {
+		if match(current.Element()) {
+			return current, index
+		}
+		index++
+	}
+	return nil, -1
+}
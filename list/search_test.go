@@ -0,0 +1,105 @@
+package list
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newIntList(values ...int) *List[int] {
+	l := NewList[int]()
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+func TestFindFunc(t *testing.T) {
+	l := newIntList(1, 3, 4, 6)
+	node := l.FindFunc(isEven)
+	require.NotNil(t, node)
+	assert.Equal(t, 4, node.Element())
+
+	// The returned node is a usable handle
+	_, err := l.Remove(node)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 3, 6}, listValues(l))
+
+	assert.Nil(t, l.FindFunc(func(v int) bool { return v > 10 }))
+	assert.Nil(t, NewList[int]().FindFunc(isEven))
+}
+
+func TestIndexFunc(t *testing.T) {
+	l := newIntList(1, 3, 4, 6)
+	assert.Equal(t, 2, l.IndexFunc(isEven))
+	assert.Equal(t, 0, l.IndexFunc(func(v int) bool { return v == 1 }))
+	assert.Equal(t, -1, l.IndexFunc(func(v int) bool { return v < 0 }))
+	assert.True(t, l.ContainsFunc(isEven))
+	assert.False(t, newIntList(1, 3).ContainsFunc(isEven))
+}
+
+func TestDeleteFunc(t *testing.T) {
+	l := newIntList(2, 1, 4, 4, 3, 6)
+	assert.Equal(t, 4, l.DeleteFunc(isEven))
+	assert.Equal(t, []int{1, 3}, listValues(l))
+	assert.Equal(t, 2, l.Len())
+	assert.Equal(t, 1, l.Front().Element())
+	assert.Equal(t, 3, l.Back().Element())
+
+	assert.Equal(t, 0, l.DeleteFunc(isEven))
+	assert.Equal(t, 2, l.DeleteFunc(func(int) bool { return true }))
+	assert.Nil(t, l.Front())
+	assert.Nil(t, l.Back())
+}
+
+func TestComparableHelpers(t *testing.T) {
+	l := NewList[string]()
+	for _, v := range []string{"a", "b", "a", "c"} {
+		l.PushBack(v)
+	}
+
+	assert.Equal(t, 1, Index(l, "b"))
+	assert.Equal(t, -1, Index(l, "z"))
+	assert.True(t, Contains(l, "c"))
+	assert.False(t, Contains(l, "z"))
+	assert.Equal(t, l.Front(), Find(l, "a"))
+	assert.Nil(t, Find(l, "z"))
+
+	assert.Equal(t, 2, RemoveAll(l, "a"))
+	assert.Equal(t, []string{"b", "c"}, listValues(l))
+	assert.Equal(t, 0, RemoveAll(l, "a"))
+}
+
+func TestSearchConcurrentAccess(t *testing.T) {
+	l := NewList[int]()
+	var wg sync.WaitGroup
+	for g := range 10 {
+		wg.Add(2)
+		go func(base int) {
+			defer wg.Done()
+			for i := range 200 {
+				l.PushBack(base*200 + i)
+			}
+		}(g)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				_ = l.IndexFunc(isEven)
+				_ = Contains(l, 7)
+				_ = l.DeleteFunc(func(v int) bool { return v%5 == 0 })
+			}
+		}()
+	}
+	wg.Wait()
+
+	l.DeleteFunc(func(v int) bool { return v%5 == 0 })
+	assert.False(t, l.ContainsFunc(func(v int) bool { return v%5 == 0 }))
+	assert.Equal(t, 1600, l.Len())
+	assert.Len(t, listValues(l), l.Len())
+}
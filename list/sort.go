@@ -0,0 +1,108 @@
+package list
+
+// SortFunc sorts the list in place in ascending order as determined by cmp,
+// which follows the slices.SortFunc convention. The sort is a stable,
+// bottom-up merge sort that relinks the existing nodes, so node handles stay
+// valid and no elements are copied. It runs in O(n log n) under the write lock.
+func (list *List[T]) SortFunc(cmp func(a, b T) int) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	if list.size < 2 {
+		return
+	}
+
+	head := list.head
+	for width := 1; width < list.size; width *= 2 {
+		var sortedHead, sortedTail *Node[T]
+		for current := head; current != nil; {
+			left := current
+			right := cutAfter(left, width)
+			current = cutAfter(right, width)
+
+			runHead, runTail := mergeRuns(left, right, cmp)
+			if sortedTail == nil {
+				sortedHead = runHead
+			} else {
+				sortedTail.setNext(runHead)
+			}
+			sortedTail = runTail
+		}
+		head = sortedHead
+	}
+
+	// The merge passes only maintain next links; rebuild prev links and the tail.
+	var prev *Node[T]
+	for current := head; current != nil; current = current.Next() {
+		current.setPrev(prev)
+		prev = current
+	}
+	list.head = head
+	list.tail = prev
+}
+
+// Reverse reverses the order of the list in place by swapping each node's
+// prev and next links.
+func (list *List[T]) Reverse() {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	for current := list.head; current != nil; {
+		next := current.Next()
+		current.setNext(current.Prev())
+		current.setPrev(next)
+		current = next
+	}
+	list.head, list.tail = list.tail, list.head
+}
+
+// cutAfter detaches the chain starting at node after n nodes and returns the
+// head of the remainder, or nil if the chain is shorter.
+func cutAfter[T any](node *Node[T], n int) *Node[T] {
+	for i := 1; node != nil && i < n; i++ {
+		node = node.Next()
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.Next()
+	node.setNext(nil)
+	return rest
+}
+
+// mergeRuns merges two sorted next-linked chains, taking from left on ties
+// to keep the sort stable, and returns the merged head and tail.
+func mergeRuns[T any](left, right *Node[T], cmp func(a, b T) int) (*Node[T], *Node[T]) {
+	var head, tail *Node[T]
+	appendNode := func(node *Node[T]) {
+		if tail == nil {
+			head = node
+		} else {
+			tail.setNext(node)
+		}
+		tail = node
+	}
+
+	for left != nil && right != nil {
+		if cmp(right.Element(), left.Element()) < 0 {
+			next := right.Next()
+			appendNode(right)
+			right = next
+		} else {
+			next := left.Next()
+			appendNode(left)
+			left = next
+		}
+	}
+	rest := left
+	if rest == nil {
+		rest = right
+	}
+	if rest != nil {
+		appendNode(rest)
+		for tail.Next() != nil {
+			tail = tail.Next()
+		}
+	}
+	return head, tail
+}
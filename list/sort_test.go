@@ -0,0 +1,133 @@
+package list
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assertLinks checks that prev links mirror next links and that the
+// head, tail and size agree with them.
+func assertLinks[T any](t *testing.T, l *List[T]) {
+	t.Helper()
+	count := 0
+	var prev *Node[T]
+	for current := l.Front(); current != nil; current = current.Next() {
+		require.Equal(t, prev, current.Prev())
+		prev = current
+		count++
+	}
+	assert.Equal(t, prev, l.Back())
+	assert.Equal(t, l.Len(), count)
+}
+
+func TestSortFunc(t *testing.T) {
+	l := NewList[int]()
+	l.SortFunc(cmp.Compare[int])
+	assert.Empty(t, listValues(l))
+
+	l.PushBack(1)
+	l.SortFunc(cmp.Compare[int])
+	assert.Equal(t, []int{1}, listValues(l))
+
+	for _, n := range []int{2, 3, 7, 64, 1000} {
+		values := rand.New(rand.NewSource(int64(n))).Perm(n)
+		l := newIntList(values...)
+		l.SortFunc(cmp.Compare[int])
+
+		slices.Sort(values)
+		assert.Equal(t, values, listValues(l))
+		assertLinks(t, l)
+	}
+}
+
+func TestSortFuncStable(t *testing.T) {
+	type record struct {
+		key int
+		id  int
+	}
+	l := NewList[record]()
+	r := rand.New(rand.NewSource(1))
+	for i := range 500 {
+		l.PushBack(record{key: r.Intn(5), id: i})
+	}
+
+	l.SortFunc(func(a, b record) int { return cmp.Compare(a.key, b.key) })
+	values := listValues(l)
+	assert.True(t, slices.IsSortedFunc(values, func(a, b record) int {
+		return cmp.Or(cmp.Compare(a.key, b.key), cmp.Compare(a.id, b.id))
+	}), "equal keys must keep their original order")
+	assertLinks(t, l)
+}
+
+func TestSortFuncKeepsNodes(t *testing.T) {
+	l := NewList[int]()
+	n3 := l.PushBack(3)
+	n1 := l.PushBack(1)
+	n2 := l.PushBack(2)
+
+	l.SortFunc(func(a, b int) int { return b - a })
+	assert.Equal(t, []int{3, 2, 1}, listValues(l))
+	assert.Equal(t, n3, l.Front())
+	assert.Equal(t, n1, l.Back())
+
+	// Handles remain valid after sorting
+	_, err := l.Remove(n2)
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 1}, listValues(l))
+}
+
+func TestReverse(t *testing.T) {
+	l := NewList[int]()
+	l.Reverse()
+	assert.Nil(t, l.Front())
+
+	l.PushBack(1)
+	l.Reverse()
+	assert.Equal(t, []int{1}, listValues(l))
+	assertLinks(t, l)
+
+	l = newIntList(1, 2, 3, 4, 5)
+	l.Reverse()
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, listValues(l))
+	assertLinks(t, l)
+
+	l.PushBack(0)
+	l.PushFront(6)
+	assert.Equal(t, []int{6, 5, 4, 3, 2, 1, 0}, listValues(l))
+	l.Reverse()
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, listValues(l))
+}
+
+func TestSortReverseConcurrentAccess(t *testing.T) {
+	l := newIntList(rand.New(rand.NewSource(4)).Perm(200)...)
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			l.SortFunc(cmp.Compare[int])
+		}()
+		go func() {
+			defer wg.Done()
+			l.Reverse()
+		}()
+		go func(v int) {
+			defer wg.Done()
+			l.PushBack(1000 + v)
+			_ = l.IndexFunc(func(x int) bool { return x == v })
+		}(i)
+	}
+	wg.Wait()
+
+	l.SortFunc(cmp.Compare[int])
+	values := listValues(l)
+	assert.Len(t, values, 210)
+	assert.True(t, slices.IsSorted(values))
+	assertLinks(t, l)
+}
@@ -0,0 +1,168 @@
+package list
+
+import (
+	"errors"
+
+	"github.com/ckshitij/collection/internal/lockorder"
+)
+
+// ErrInvalidRange is returned by Splice when to cannot be reached from from
+// in a cross-list splice, or when the destination is an end of the run.
+var ErrInvalidRange = errors.New("invalid node range")
+
+// PushBackList inserts a copy of every element of other at the back of the
+// list, in order. other may be the list itself.
+func (list *List[T]) PushBackList(other *List[T]) {
+	if other == nil {
+		return
+	}
+	unlock := lockPair(list, other)
+	defer unlock()
+
+	for current, n := other.head, other.size; n > 0; current, n = current.Next(), n-1 {
+		list.pushBackNode(NewNode(current.Element()))
+	}
+}
+
+// PushFrontList inserts a copy of every element of other at the front of
+// the list, keeping their order. other may be the list itself.
+func (list *List[T]) PushFrontList(other *List[T]) {
+	if other == nil {
+		return
+	}
+	unlock := lockPair(list, other)
+	defer unlock()
+
+	for current, n := other.tail, other.size; n > 0; current, n = current.Prev(), n-1 {
+		list.pushFrontNode(NewNode(current.Element()))
+	}
+}
+
+// Splice moves the run of nodes from through to (inclusive) out of other and
+// inserts it immediately after at, or at the front when at is nil. other may
+// be the list itself. The nodes are relinked rather than copied, so handles
+// stay valid. Within one list Splice is O(1) and trusts from to precede to
+// with at outside the run, rejecting only at == from or at == to. Across
+// lists it is O(k) for a run of k nodes, because each moved node's owner is
+// updated and the run is verified on the way.
+func (list *List[T]) Splice(at *Node[T], other *List[T], from, to *Node[T]) error {
+	if other == nil {
+		return ErrNodeNotInList
+	}
+	unlock := lockPair(list, other)
+	defer unlock()
+
+	if (at != nil && !list.owns(at)) || !other.owns(from) || !other.owns(to) {
+		return ErrNodeNotInList
+	}
+	count := 0
+	if other == list {
+		if at == from || at == to {
+			return ErrInvalidRange
+		}
+	} else {
+		for current := from; ; current = current.Next() {
+			if current == nil {
+				return ErrInvalidRange
+			}
+			count++
+			if current == to {
+				break
+			}
+		}
+	}
+
+	// Detach the run from other.
+	prev, next := from.Prev(), to.Next()
+	if prev != nil {
+		prev.setNext(next)
+	} else {
+		other.head = next
+	}
+	if next != nil {
+		next.setPrev(prev)
+	} else {
+		other.tail = prev
+	}
+	other.size -= count
+
+	// Attach it after at.
+	var after *Node[T]
+	if at != nil {
+		after = at.Next()
+		at.setNext(from)
+	} else {
+		after = list.head
+		list.head = from
+	}
+	from.setPrev(at)
+	to.setNext(after)
+	if after != nil {
+		after.setPrev(to)
+	} else {
+		list.tail = to
+	}
+	list.size += count
+
+	if other != list {
+		for current := from; ; current = current.Next() {
+			current.setOwner(list)
+			if current == to {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// SplitAt moves the elements before position into a new front list and the
+// rest into a new back list, leaving the receiver empty. Nodes are relinked
+// rather than copied, so handles stay valid and now belong to the new lists.
+// Unlike the other positional methods, SplitAt does not fail: position is
+// clamped to [0, Len()].
+func (list *List[T]) SplitAt(position int) (*List[T], *List[T]) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	position = min(max(position, 0), list.size)
+	front, back := NewList[T](), NewList[T]()
+
+	var split *Node[T] // first node of the back list
+	if position < list.size {
+		split, _ = list.nodeAt(position)
+	}
+
+	if position > 0 {
+		front.head = list.head
+		front.tail = list.tail
+		if split != nil {
+			front.tail = split.Prev()
+			front.tail.setNext(nil)
+			split.setPrev(nil)
+		}
+		front.size = position
+	}
+	if split != nil {
+		back.head = split
+		back.tail = list.tail
+		back.size = list.size - position
+	}
+	for current := front.head; current != nil; current = current.Next() {
+		current.setOwner(front)
+	}
+	for current := back.head; current != nil; current = current.Next() {
+		current.setOwner(back)
+	}
+
+	list.head = nil
+	list.tail = nil
+	list.size = 0
+	return front, back
+}
+
+// lockPair write-locks both lists, or just one when they are the same, in
+// a stable order so that concurrent cross-list operations cannot deadlock.
+// It returns the matching unlock.
+func lockPair[T any](a, b *List[T]) func() {
+	return lockorder.Lock(&a.order, &a.mu, &b.order, &b.mu)
+}
@@ -0,0 +1,218 @@
+package list
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushBackList(t *testing.T) {
+	l := newIntList(1, 2)
+	other := newIntList(3, 4)
+
+	l.PushBackList(other)
+	assert.Equal(t, []int{1, 2, 3, 4}, listValues(l))
+	assert.Equal(t, []int{3, 4}, listValues(other), "other is left unchanged")
+	assertLinks(t, l)
+
+	l.PushBackList(l)
+	assert.Equal(t, []int{1, 2, 3, 4, 1, 2, 3, 4}, listValues(l))
+	assertLinks(t, l)
+
+	l.PushBackList(NewList[int]())
+	l.PushBackList(nil)
+	assert.Equal(t, 8, l.Len())
+
+	empty := NewList[int]()
+	empty.PushBackList(other)
+	assert.Equal(t, []int{3, 4}, listValues(empty))
+}
+
+func TestPushFrontList(t *testing.T) {
+	l := newIntList(3, 4)
+	l.PushFrontList(newIntList(1, 2))
+	assert.Equal(t, []int{1, 2, 3, 4}, listValues(l))
+	assertLinks(t, l)
+
+	l.PushFrontList(l)
+	assert.Equal(t, []int{1, 2, 3, 4, 1, 2, 3, 4}, listValues(l))
+	assertLinks(t, l)
+
+	empty := NewList[int]()
+	empty.PushFrontList(newIntList(5))
+	assert.Equal(t, []int{5}, listValues(empty))
+}
+
+func TestSplice(t *testing.T) {
+	l := newIntList(1, 2, 6)
+	other := NewList[int]()
+	other.PushBack(0)
+	from := other.PushBack(3)
+	other.PushBack(4)
+	to := other.PushBack(5)
+	other.PushBack(9)
+
+	at := l.FindFunc(func(v int) bool { return v == 2 })
+	require.NoError(t, l.Splice(at, other, from, to))
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, listValues(l))
+	assert.Equal(t, []int{0, 9}, listValues(other))
+	assertLinks(t, l)
+	assertLinks(t, other)
+
+	// Moved nodes now belong to the destination list
+	_, err := other.Remove(from)
+	assert.Equal(t, ErrNodeNotInList, err)
+	_, err = l.Remove(from)
+	require.NoError(t, err)
+
+	// Splice to the front and drain the source completely
+	require.NoError(t, l.Splice(nil, other, other.Front(), other.Back()))
+	assert.Equal(t, []int{0, 9, 1, 2, 4, 5, 6}, listValues(l))
+	assert.Equal(t, 0, other.Len())
+	assert.Nil(t, other.Front())
+	assert.Nil(t, other.Back())
+	assertLinks(t, l)
+
+	// Splice onto the tail of an empty list
+	require.NoError(t, other.Splice(nil, l, l.Back(), l.Back()))
+	assert.Equal(t, []int{6}, listValues(other))
+	assertLinks(t, other)
+	assertLinks(t, l)
+}
+
+func TestSpliceWithinList(t *testing.T) {
+	l := NewList[int]()
+	n1 := l.PushBack(1)
+	n2 := l.PushBack(2)
+	n3 := l.PushBack(3)
+	n4 := l.PushBack(4)
+
+	require.NoError(t, l.Splice(n4, l, n1, n2))
+	assert.Equal(t, []int{3, 4, 1, 2}, listValues(l))
+	assertLinks(t, l)
+
+	require.NoError(t, l.Splice(nil, l, n1, n1))
+	assert.Equal(t, []int{1, 3, 4, 2}, listValues(l))
+	assertLinks(t, l)
+
+	// The destination may not be inside the moved run
+	assert.Equal(t, ErrInvalidRange, l.Splice(n4, l, n3, n4))
+	assert.Equal(t, []int{1, 3, 4, 2}, listValues(l))
+	assert.Equal(t, 4, l.Len())
+}
+
+func TestSpliceErrors(t *testing.T) {
+	l := newIntList(1)
+	other := NewList[int]()
+	a := other.PushBack(2)
+	b := other.PushBack(3)
+
+	assert.Equal(t, ErrNodeNotInList, l.Splice(nil, nil, a, b))
+	assert.Equal(t, ErrNodeNotInList, l.Splice(a, other, a, b))
+	assert.Equal(t, ErrNodeNotInList, l.Splice(nil, other, l.Front(), b))
+	assert.Equal(t, ErrNodeNotInList, l.Splice(nil, other, a, nil))
+	assert.Equal(t, ErrInvalidRange, l.Splice(nil, other, b, a))
+
+	assert.Equal(t, []int{1}, listValues(l))
+	assert.Equal(t, []int{2, 3}, listValues(other))
+}
+
+func TestSplitAt(t *testing.T) {
+	for _, tc := range []struct {
+		position    int
+		front, back []int
+	}{
+		{-1, []int{}, []int{1, 2, 3, 4, 5}},
+		{0, []int{}, []int{1, 2, 3, 4, 5}},
+		{1, []int{1}, []int{2, 3, 4, 5}},
+		{3, []int{1, 2, 3}, []int{4, 5}},
+		{5, []int{1, 2, 3, 4, 5}, []int{}},
+		{9, []int{1, 2, 3, 4, 5}, []int{}},
+	} {
+		l := newIntList(1, 2, 3, 4, 5)
+		front, back := l.SplitAt(tc.position)
+		assert.Equal(t, tc.front, listValues(front), "position %d", tc.position)
+		assert.Equal(t, tc.back, listValues(back), "position %d", tc.position)
+		assertLinks(t, front)
+		assertLinks(t, back)
+		assert.Equal(t, 0, l.Len())
+		assert.Nil(t, l.Front())
+	}
+
+	front, back := NewList[int]().SplitAt(0)
+	assert.Equal(t, 0, front.Len())
+	assert.Equal(t, 0, back.Len())
+}
+
+func TestSplitAtEmptiesReceiver(t *testing.T) {
+	l := newIntList(1, 2, 3)
+	front, back := l.SplitAt(2)
+
+	assert.Equal(t, 0, l.Len())
+	assert.Nil(t, l.Front())
+	assert.Nil(t, l.Back())
+	assert.Empty(t, l.Snapshot())
+
+	// The receiver stays usable and independent of the new lists
+	l.PushBack(9)
+	assert.Equal(t, []int{9}, listValues(l))
+	assert.Equal(t, []int{1, 2}, listValues(front))
+	assert.Equal(t, []int{3}, listValues(back))
+}
+
+func TestSplitAtKeepsNodes(t *testing.T) {
+	l := NewList[int]()
+	n1 := l.PushBack(1)
+	n2 := l.PushBack(2)
+
+	front, back := l.SplitAt(1)
+	_, err := l.Remove(n1)
+	assert.Equal(t, ErrNodeNotInList, err)
+	require.NoError(t, front.MoveToBack(n1))
+	_, err = back.Remove(n2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, back.Len())
+}
+
+func TestCrossListConcurrentAccess(t *testing.T) {
+	a := newIntList(1, 2, 3)
+	b := newIntList(4, 5, 6)
+	var wg sync.WaitGroup
+
+	// Operations in opposite directions must not deadlock
+	for range 50 {
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			a.PushBackList(b)
+			for a.Len() > 16 {
+				a.PopFront()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			b.PushFrontList(a)
+			for b.Len() > 16 {
+				b.PopBack()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if node := b.Front(); node != nil {
+				_ = a.Splice(nil, b, node, node)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if node := a.Back(); node != nil {
+				_ = b.Splice(nil, a, node, node)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assertLinks(t, a)
+	assertLinks(t, b)
+}
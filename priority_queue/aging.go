@@ -0,0 +1,135 @@
+package pq
+
+import "time"
+
+// AgingFunc computes the effective priority of an element that has been
+// queued for age. Higher scores are served first.
+type AgingFunc[T any] func(element T, age time.Duration) float64
+
+// LinearAging returns an AgingFunc whose score is the element's base
+// priority plus rate for every second it has waited.
+func LinearAging[T any](priority func(T) float64, rate float64) AgingFunc[T] {
+	return func(element T, age time.Duration) float64 {
+		return priority(element) + rate*age.Seconds()
+	}
+}
+
+// aged is an AgingQueue element with its enqueue time and the score it had
+// at the last refresh.
+type aged[T any] struct {
+	value    T
+	enqueued time.Time
+	score    float64
+}
+
+// AgingQueue is a thread-safe priority queue that prevents starvation by
+// letting an element's priority grow with its time in the queue. Scores are
+// recomputed and the heap rebuilt in O(n) on Refresh, and lazily on Pop and
+// Peek once the refresh interval has elapsed. Elements with equal scores pop
+// in insertion order.
+type AgingQueue[T any] struct {
+	heap         *PriorityQueue[aged[T]]
+	aging        AgingFunc[T]
+	clock        Clock
+	refreshEvery time.Duration
+	lastRefresh  time.Time // guarded by heap.mu
+}
+
+// NewAgingQueue initializes an empty AgingQueue. Pop and Peek refresh the
+// scores when at least refreshEvery has passed since the last refresh; zero
+// refreshes on every call for exact ordering, and a negative interval
+// disables lazy refreshes so only Refresh updates them. A nil clock uses the
+// system clock; opts configure the underlying heap, which is always stable.
+func NewAgingQueue[T any](aging AgingFunc[T], refreshEvery time.Duration, clock Clock, opts ...Option) *AgingQueue[T] {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &AgingQueue[T]{
+		heap: newPriorityQueue(func(a, b aged[T]) bool {
+			return a.score > b.score
+		}, stableOptions(opts), nil),
+		aging:        aging,
+		clock:        clock,
+		refreshEvery: refreshEvery,
+		lastRefresh:  clock.Now(),
+	}
+}
+
+// Push inserts data with the score it has at age zero.
+func (aq *AgingQueue[T]) Push(data T) {
+	now := aq.clock.Now()
+	aq.heap.Push(aged[T]{value: data, enqueued: now, score: aq.aging(data, 0)})
+}
+
+// Pop removes and returns the element with the highest effective priority.
+// Returns the zero value of the type if the queue is empty.
+func (aq *AgingQueue[T]) Pop() T {
+	aq.heap.mu.Lock()
+	defer aq.heap.mu.Unlock()
+
+	var zero T
+	if len(aq.heap.table) == 0 {
+		return zero
+	}
+	aq.refreshIfDue()
+	return aq.heap.removeAt(0).value
+}
+
+// Peek returns the element with the highest effective priority without
+// removing it.
+func (aq *AgingQueue[T]) Peek() (T, bool) {
+	aq.heap.mu.Lock()
+	defer aq.heap.mu.Unlock()
+
+	var zero T
+	if len(aq.heap.table) == 0 {
+		return zero, false
+	}
+	aq.refreshIfDue()
+	return aq.heap.table[0].value.value, true
+}
+
+// Refresh recomputes every score at the current time and rebuilds the heap.
+// Call it periodically when lazy refreshes are disabled.
+func (aq *AgingQueue[T]) Refresh() {
+	aq.heap.mu.Lock()
+	defer aq.heap.mu.Unlock()
+
+	aq.refresh()
+}
+
+// Size returns the number of elements.
+func (aq *AgingQueue[T]) Size() int {
+	return aq.heap.Size()
+}
+
+// Empty returns true if the queue is empty.
+func (aq *AgingQueue[T]) Empty() bool {
+	return aq.heap.Empty()
+}
+
+// Clear removes all elements.
+func (aq *AgingQueue[T]) Clear() {
+	aq.heap.Clear()
+}
+
+// --- Private methods (assume caller has heap.mu) ---
+
+func (aq *AgingQueue[T]) refreshIfDue() {
+	if aq.refreshEvery < 0 {
+		return
+	}
+	if aq.clock.Now().Sub(aq.lastRefresh) >= aq.refreshEvery {
+		aq.refresh()
+	}
+}
+
+func (aq *AgingQueue[T]) refresh() {
+	now := aq.clock.Now()
+	for i := range aq.heap.table {
+		e := &aq.heap.table[i].value
+		e.score = aq.aging(e.value, now.Sub(e.enqueued))
+	}
+	aq.heap.buildHeap()
+	aq.lastRefresh = now
+}
@@ -0,0 +1,136 @@
+package pq
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type agingJob struct {
+	name     string
+	priority float64
+}
+
+func jobPriority(j agingJob) float64 {
+	return j.priority
+}
+
+func TestAgingQueueWithoutAging(t *testing.T) {
+	aq := NewAgingQueue(LinearAging(jobPriority, 0), 0, newFakeClock())
+	assert.True(t, aq.Empty())
+	assert.Equal(t, agingJob{}, aq.Pop())
+	_, ok := aq.Peek()
+	assert.False(t, ok)
+
+	aq.Push(agingJob{"low", 1})
+	aq.Push(agingJob{"high", 10})
+	aq.Push(agingJob{"mid", 5})
+	aq.Push(agingJob{"mid2", 5})
+	assert.Equal(t, 4, aq.Size())
+
+	top, ok := aq.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "high", top.name)
+	for _, want := range []string{"high", "mid", "mid2", "low"} {
+		assert.Equal(t, want, aq.Pop().name)
+	}
+}
+
+func TestAgingQueuePreventsStarvation(t *testing.T) {
+	clock := newFakeClock()
+	aq := NewAgingQueue(LinearAging(jobPriority, 1), 0, clock)
+
+	aq.Push(agingJob{"old-low", 1})
+	clock.Advance(20 * time.Second)
+	aq.Push(agingJob{"new-high", 10})
+
+	// old-low scores 1+20 = 21, new-high scores 10
+	assert.Equal(t, "old-low", aq.Pop().name)
+	assert.Equal(t, "new-high", aq.Pop().name)
+}
+
+func TestAgingQueueLazyRefreshInterval(t *testing.T) {
+	clock := newFakeClock()
+	aq := NewAgingQueue(LinearAging(jobPriority, 1), time.Minute, clock)
+
+	aq.Push(agingJob{"old-low", 1})
+	aq.Push(agingJob{"new-high", 10})
+	clock.Advance(20 * time.Second)
+
+	// Within the interval the scores are stale
+	top, _ := aq.Peek()
+	assert.Equal(t, "new-high", top.name)
+
+	// Once the interval elapses the heap is rebuilt with current ages;
+	// both have aged equally, so the base priority still decides
+	clock.Advance(time.Minute)
+	top, _ = aq.Peek()
+	assert.Equal(t, "new-high", top.name)
+
+	aq.Push(agingJob{"fresh-high", 30})
+	clock.Advance(time.Minute)
+	assert.Equal(t, "new-high", aq.Pop().name, "10+140 beats 30+60")
+}
+
+func TestAgingQueueManualRefresh(t *testing.T) {
+	clock := newFakeClock()
+	aq := NewAgingQueue(LinearAging(jobPriority, 1), -1, clock)
+
+	aq.Push(agingJob{"old-low", 1})
+	clock.Advance(time.Hour)
+	aq.Push(agingJob{"new-high", 10})
+
+	top, _ := aq.Peek()
+	assert.Equal(t, "new-high", top.name, "no lazy refresh when disabled")
+
+	aq.Refresh()
+	assert.Equal(t, "old-low", aq.Pop().name)
+
+	aq.Clear()
+	assert.True(t, aq.Empty())
+}
+
+func TestAgingQueueCustomFunc(t *testing.T) {
+	clock := newFakeClock()
+	// Only jobs waiting longer than a minute get a fixed boost
+	boost := func(j agingJob, age time.Duration) float64 {
+		if age > time.Minute {
+			return j.priority + 100
+		}
+		return j.priority
+	}
+	aq := NewAgingQueue(boost, 0, clock)
+	aq.Push(agingJob{"a", 1})
+	clock.Advance(30 * time.Second)
+	aq.Push(agingJob{"b", 50})
+
+	assert.Equal(t, "b", aq.Pop().name)
+	aq.Push(agingJob{"c", 50})
+	clock.Advance(45 * time.Second)
+	assert.Equal(t, "a", aq.Pop().name)
+}
+
+func TestAgingQueueConcurrentAccess(t *testing.T) {
+	aq := NewAgingQueue(LinearAging(func(v int) float64 { return float64(v) }, 0.5), 0, nil)
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(2)
+		go func(base int) {
+			defer wg.Done()
+			for i := range 200 {
+				aq.Push(base*200 + i)
+			}
+		}(g)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				_ = aq.Pop()
+				_, _ = aq.Peek()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.GreaterOrEqual(t, aq.Size(), 0)
+}
@@ -0,0 +1,137 @@
+package pq
+
+import (
+	"context"
+	"time"
+)
+
+// Clock abstracts the passage of time for DelayQueue so tests can control it.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel that receives once d has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the Clock backed by the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// delayed is a DelayQueue element paired with the time it becomes poppable.
+type delayed[T any] struct {
+	value    T
+	deadline time.Time
+}
+
+// DelayQueue is a thread-safe queue whose elements can only be popped once
+// their deadline has passed. Elements with the same deadline pop in
+// insertion order.
+type DelayQueue[T any] struct {
+	heap  *PriorityQueue[delayed[T]]
+	clock Clock
+}
+
+// NewDelayQueue initializes an empty DelayQueue. A nil clock uses the system
+// clock; opts configure the underlying heap, which is always stable.
+func NewDelayQueue[T any](clock Clock, opts ...Option) *DelayQueue[T] {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &DelayQueue[T]{
+		heap: newPriorityQueue(func(a, b delayed[T]) bool {
+			return a.deadline.Before(b.deadline)
+		}, stableOptions(opts), nil),
+		clock: clock,
+	}
+}
+
+// Push inserts data so that it becomes poppable at deadline.
+func (dq *DelayQueue[T]) Push(data T, deadline time.Time) {
+	dq.heap.Push(delayed[T]{value: data, deadline: deadline})
+}
+
+// PushAfter inserts data so that it becomes poppable after delay.
+func (dq *DelayQueue[T]) PushAfter(data T, delay time.Duration) {
+	dq.Push(data, dq.clock.Now().Add(delay))
+}
+
+// TryPop removes and returns the earliest element if its deadline has passed.
+// The boolean is false if no element is due yet.
+func (dq *DelayQueue[T]) TryPop() (T, bool) {
+	dq.heap.mu.Lock()
+	defer dq.heap.mu.Unlock()
+
+	value, _, ok := dq.popDue()
+	return value, ok
+}
+
+// PopWait removes and returns the earliest element, blocking until its
+// deadline passes or ctx is done (ctx.Err()). An element pushed with an
+// earlier deadline while waiting is picked up immediately.
+func (dq *DelayQueue[T]) PopWait(ctx context.Context) (T, error) {
+	var zero T
+	for {
+		dq.heap.mu.Lock()
+		value, wait, ok := dq.popDue()
+		if ok {
+			dq.heap.mu.Unlock()
+			return value, nil
+		}
+		var timer <-chan time.Time
+		if wait > 0 {
+			timer = dq.clock.After(wait)
+		}
+		ready := dq.heap.pushed.Wait()
+		dq.heap.mu.Unlock()
+
+		select {
+		case <-ready:
+		case <-timer:
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// Peek returns the earliest element and its deadline without removing it,
+// whether or not the deadline has passed.
+func (dq *DelayQueue[T]) Peek() (T, time.Time, bool) {
+	next, ok := dq.heap.Peek()
+	return next.value, next.deadline, ok
+}
+
+// Size returns the number of elements, due or not.
+func (dq *DelayQueue[T]) Size() int {
+	return dq.heap.Size()
+}
+
+// Empty returns true if the queue is empty.
+func (dq *DelayQueue[T]) Empty() bool {
+	return dq.heap.Empty()
+}
+
+// Clear removes all elements.
+func (dq *DelayQueue[T]) Clear() {
+	dq.heap.Clear()
+}
+
+// popDue pops the earliest element if it is due. Otherwise it returns how
+// long until it is, or zero if the queue is empty. Caller must hold heap.mu.
+func (dq *DelayQueue[T]) popDue() (T, time.Duration, bool) {
+	var zero T
+	if len(dq.heap.table) == 0 {
+		return zero, 0, false
+	}
+	wait := dq.heap.table[0].value.deadline.Sub(dq.clock.Now())
+	if wait > 0 {
+		return zero, wait, false
+	}
+	return dq.heap.removeAt(0).value, 0, true
+}
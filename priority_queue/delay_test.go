@@ -0,0 +1,192 @@
+package pq
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a manually advanced Clock for tests.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	pending []fakeTimer
+}
+
+type fakeTimer struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	c.pending = append(c.pending, fakeTimer{deadline: c.now.Add(d), ch: ch})
+	return ch
+}
+
+// Advance moves the clock forward and fires every timer that became due.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	remaining := c.pending[:0]
+	for _, timer := range c.pending {
+		if timer.deadline.After(c.now) {
+			remaining = append(remaining, timer)
+		} else {
+			timer.ch <- c.now
+		}
+	}
+	c.pending = remaining
+}
+
+// Timers returns how many timers are waiting to fire.
+func (c *fakeClock) Timers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.pending)
+}
+
+func TestDelayQueueTryPop(t *testing.T) {
+	clock := newFakeClock()
+	dq := NewDelayQueue[string](clock)
+
+	dq.PushAfter("later", 2*time.Second)
+	dq.PushAfter("soon", time.Second)
+	dq.Push("overdue", clock.Now().Add(-time.Second))
+	assert.Equal(t, 3, dq.Size())
+
+	v, ok := dq.TryPop()
+	assert.True(t, ok)
+	assert.Equal(t, "overdue", v)
+
+	_, ok = dq.TryPop()
+	assert.False(t, ok, "nothing is due yet")
+
+	clock.Advance(time.Second)
+	v, ok = dq.TryPop()
+	assert.True(t, ok)
+	assert.Equal(t, "soon", v)
+
+	v, deadline, ok := dq.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "later", v)
+	assert.Equal(t, clock.Now().Add(time.Second), deadline)
+
+	dq.Clear()
+	assert.True(t, dq.Empty())
+	_, ok = dq.TryPop()
+	assert.False(t, ok)
+}
+
+func TestDelayQueueSameDeadlineFIFO(t *testing.T) {
+	clock := newFakeClock()
+	dq := NewDelayQueue[int](clock)
+	deadline := clock.Now()
+	for i := range 10 {
+		dq.Push(i, deadline)
+	}
+	for i := range 10 {
+		v, ok := dq.TryPop()
+		require.True(t, ok)
+		assert.Equal(t, i, v)
+	}
+}
+
+func TestDelayQueuePopWait(t *testing.T) {
+	clock := newFakeClock()
+	dq := NewDelayQueue[string](clock)
+	dq.PushAfter("job", time.Minute)
+
+	result := make(chan string)
+	go func() {
+		v, err := dq.PopWait(context.Background())
+		assert.NoError(t, err)
+		result <- v
+	}()
+
+	require.Eventually(t, func() bool { return clock.Timers() == 1 }, time.Second, time.Millisecond)
+	clock.Advance(30 * time.Second)
+	select {
+	case <-result:
+		t.Fatal("PopWait returned before the deadline")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	clock.Advance(30 * time.Second)
+	assert.Equal(t, "job", <-result)
+	assert.True(t, dq.Empty())
+}
+
+func TestDelayQueuePopWaitEarlierPush(t *testing.T) {
+	clock := newFakeClock()
+	dq := NewDelayQueue[string](clock)
+	dq.PushAfter("slow", time.Hour)
+
+	result := make(chan string)
+	go func() {
+		v, err := dq.PopWait(context.Background())
+		assert.NoError(t, err)
+		result <- v
+	}()
+
+	require.Eventually(t, func() bool { return clock.Timers() == 1 }, time.Second, time.Millisecond)
+	dq.PushAfter("fast", 0)
+	assert.Equal(t, "fast", <-result)
+	assert.Equal(t, 1, dq.Size())
+}
+
+func TestDelayQueuePopWaitEmpty(t *testing.T) {
+	clock := newFakeClock()
+	dq := NewDelayQueue[int](clock)
+
+	result := make(chan int)
+	go func() {
+		v, err := dq.PopWait(context.Background())
+		assert.NoError(t, err)
+		result <- v
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 0, clock.Timers(), "an empty queue should not arm a timer")
+	dq.PushAfter(5, 0)
+	assert.Equal(t, 5, <-result)
+}
+
+func TestDelayQueuePopWaitContext(t *testing.T) {
+	dq := NewDelayQueue[int](newFakeClock())
+	dq.PushAfter(1, time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := dq.PopWait(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, dq.Size())
+}
+
+func TestDelayQueueSystemClock(t *testing.T) {
+	dq := NewDelayQueue[int](nil)
+	dq.PushAfter(1, 5*time.Millisecond)
+
+	start := time.Now()
+	v, err := dq.PopWait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.GreaterOrEqual(t, time.Since(start), 5*time.Millisecond)
+}
@@ -0,0 +1,137 @@
+package pq
+
+import "cmp"
+
+// IndexedPriorityQueue is a thread-safe priority queue of unique keys, each
+// with a priority that can be looked up, changed or removed by key in
+// O(log n). It suits graph algorithms such as Dijkstra and Prim.
+type IndexedPriorityQueue[K comparable, P any] struct {
+	heap  *PriorityQueue[keyed[K, P]]
+	index map[K]*Handle[keyed[K, P]] // guarded by heap.mu
+}
+
+// keyed is a key paired with its current priority.
+type keyed[K comparable, P any] struct {
+	key      K
+	priority P
+}
+
+// NewIndexedPriorityQueue initializes an empty IndexedPriorityQueue where
+// compFunc(a, b) reports whether priority a ranks above priority b and opts
+// configure the underlying heap.
+func NewIndexedPriorityQueue[K comparable, P any](compFunc Comparable[P], opts ...Option) *IndexedPriorityQueue[K, P] {
+	return &IndexedPriorityQueue[K, P]{
+		heap: NewPriorityQueueWith(func(a, b keyed[K, P]) bool {
+			return compFunc(a.priority, b.priority)
+		}, opts...),
+		index: make(map[K]*Handle[keyed[K, P]]),
+	}
+}
+
+// NewMinIndexedPQ creates an IndexedPriorityQueue that pops the key with the
+// smallest priority first.
+func NewMinIndexedPQ[K comparable, P cmp.Ordered](opts ...Option) *IndexedPriorityQueue[K, P] {
+	return NewIndexedPriorityQueue[K](cmp.Less[P], opts...)
+}
+
+// NewMaxIndexedPQ creates an IndexedPriorityQueue that pops the key with the
+// largest priority first.
+func NewMaxIndexedPQ[K comparable, P cmp.Ordered](opts ...Option) *IndexedPriorityQueue[K, P] {
+	return NewIndexedPriorityQueue[K](func(a, b P) bool {
+		return cmp.Less(b, a)
+	}, opts...)
+}
+
+// Set inserts key with the given priority, or changes the priority of key
+// if it is already queued.
+func (ipq *IndexedPriorityQueue[K, P]) Set(key K, priority P) {
+	ipq.heap.mu.Lock()
+	defer ipq.heap.mu.Unlock()
+
+	if handle, ok := ipq.index[key]; ok {
+		ipq.heap.table[handle.index].value.priority = priority
+		ipq.heap.fix(handle.index)
+		return
+	}
+	ipq.index[key] = ipq.heap.pushHandle(keyed[K, P]{key: key, priority: priority})
+}
+
+// Contains reports whether key is queued.
+func (ipq *IndexedPriorityQueue[K, P]) Contains(key K) bool {
+	ipq.heap.mu.RLock()
+	defer ipq.heap.mu.RUnlock()
+
+	_, ok := ipq.index[key]
+	return ok
+}
+
+// Get returns the priority of key.
+// The boolean is false if key is not queued.
+func (ipq *IndexedPriorityQueue[K, P]) Get(key K) (P, bool) {
+	ipq.heap.mu.RLock()
+	defer ipq.heap.mu.RUnlock()
+
+	var zero P
+	handle, ok := ipq.index[key]
+	if !ok {
+		return zero, false
+	}
+	return ipq.heap.table[handle.index].value.priority, true
+}
+
+// Delete removes key and returns its priority.
+// The boolean is false if key was not queued.
+func (ipq *IndexedPriorityQueue[K, P]) Delete(key K) (P, bool) {
+	ipq.heap.mu.Lock()
+	defer ipq.heap.mu.Unlock()
+
+	var zero P
+	handle, ok := ipq.index[key]
+	if !ok {
+		return zero, false
+	}
+	delete(ipq.index, key)
+	return ipq.heap.removeAt(handle.index).priority, true
+}
+
+// PopKey removes and returns the key with the highest priority along with
+// that priority. The boolean is false if the queue is empty.
+func (ipq *IndexedPriorityQueue[K, P]) PopKey() (K, P, bool) {
+	ipq.heap.mu.Lock()
+	defer ipq.heap.mu.Unlock()
+
+	if len(ipq.heap.table) == 0 {
+		var key K
+		var priority P
+		return key, priority, false
+	}
+	top := ipq.heap.removeAt(0)
+	delete(ipq.index, top.key)
+	return top.key, top.priority, true
+}
+
+// Peek returns the key with the highest priority and that priority without
+// removing it. The boolean is false if the queue is empty.
+func (ipq *IndexedPriorityQueue[K, P]) Peek() (K, P, bool) {
+	top, ok := ipq.heap.Peek()
+	return top.key, top.priority, ok
+}
+
+// Size returns the number of queued keys.
+func (ipq *IndexedPriorityQueue[K, P]) Size() int {
+	return ipq.heap.Size()
+}
+
+// Empty returns true if no key is queued.
+func (ipq *IndexedPriorityQueue[K, P]) Empty() bool {
+	return ipq.heap.Empty()
+}
+
+// Clear removes all keys.
+func (ipq *IndexedPriorityQueue[K, P]) Clear() {
+	ipq.heap.mu.Lock()
+	defer ipq.heap.mu.Unlock()
+
+	ipq.heap.clear()
+	clear(ipq.index)
+}
@@ -0,0 +1,139 @@
+package pq
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIndexedPriorityQueue(t *testing.T) {
+	ipq := NewMinIndexedPQ[string, int]()
+	assert.True(t, ipq.Empty())
+	assert.False(t, ipq.Contains("a"))
+
+	_, _, ok := ipq.PopKey()
+	assert.False(t, ok)
+	_, _, ok = ipq.Peek()
+	assert.False(t, ok)
+	_, ok = ipq.Get("a")
+	assert.False(t, ok)
+	_, ok = ipq.Delete("a")
+	assert.False(t, ok)
+}
+
+func TestIndexedPriorityQueueSet(t *testing.T) {
+	ipq := NewMinIndexedPQ[string, int]()
+	ipq.Set("a", 5)
+	ipq.Set("b", 3)
+	ipq.Set("c", 8)
+	assert.Equal(t, 3, ipq.Size())
+
+	key, prio, ok := ipq.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "b", key)
+	assert.Equal(t, 3, prio)
+
+	// Decrease and increase existing keys
+	ipq.Set("c", 1)
+	ipq.Set("b", 10)
+	assert.Equal(t, 3, ipq.Size())
+	prio, ok = ipq.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 10, prio)
+
+	for _, want := range []string{"c", "a", "b"} {
+		key, _, ok := ipq.PopKey()
+		require.True(t, ok)
+		assert.Equal(t, want, key)
+		assert.False(t, ipq.Contains(key))
+	}
+	assert.True(t, ipq.Empty())
+}
+
+func TestIndexedPriorityQueueDelete(t *testing.T) {
+	ipq := NewMaxIndexedPQ[int, float64]()
+	for i := range 10 {
+		ipq.Set(i, float64(i))
+	}
+
+	prio, ok := ipq.Delete(9)
+	assert.True(t, ok)
+	assert.Equal(t, 9.0, prio)
+	_, ok = ipq.Delete(4)
+	assert.True(t, ok)
+	_, ok = ipq.Delete(4)
+	assert.False(t, ok)
+
+	for _, want := range []int{8, 7, 6, 5, 3, 2, 1, 0} {
+		key, _, _ := ipq.PopKey()
+		assert.Equal(t, want, key)
+	}
+
+	ipq.Set(1, 1)
+	ipq.Clear()
+	assert.True(t, ipq.Empty())
+	assert.False(t, ipq.Contains(1))
+	ipq.Set(1, 2)
+	prio, _ = ipq.Get(1)
+	assert.Equal(t, 2.0, prio)
+}
+
+func TestIndexedPriorityQueueDijkstra(t *testing.T) {
+	type edge struct{ to, weight int }
+	graph := map[int][]edge{
+		0: {{1, 4}, {2, 1}},
+		2: {{1, 2}, {3, 5}},
+		1: {{3, 1}},
+	}
+
+	dist := map[int]int{0: 0}
+	ipq := NewMinIndexedPQ[int, int]()
+	ipq.Set(0, 0)
+	for !ipq.Empty() {
+		u, d, _ := ipq.PopKey()
+		for _, e := range graph[u] {
+			best, seen := dist[e.to]
+			if !seen {
+				best = math.MaxInt
+			}
+			if d+e.weight < best {
+				dist[e.to] = d + e.weight
+				ipq.Set(e.to, d+e.weight)
+			}
+		}
+	}
+	assert.Equal(t, map[int]int{0: 0, 1: 3, 2: 1, 3: 4}, dist)
+}
+
+func TestIndexedPriorityQueueConcurrentAccess(t *testing.T) {
+	ipq := NewMinIndexedPQ[int, int]()
+	var wg sync.WaitGroup
+	for g := range 10 {
+		wg.Add(1)
+		go func(base int) {
+			defer wg.Done()
+			for i := range 100 {
+				key := (base*100 + i) % 250
+				ipq.Set(key, i)
+				_, _ = ipq.Get(key)
+				if i%3 == 0 {
+					_, _ = ipq.Delete(key)
+				}
+				if i%7 == 0 {
+					_, _, _ = ipq.PopKey()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	seen := make(map[int]bool)
+	for !ipq.Empty() {
+		key, _, _ := ipq.PopKey()
+		assert.False(t, seen[key], "keys must be unique")
+		seen[key] = true
+	}
+}
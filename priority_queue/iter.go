@@ -0,0 +1,68 @@
+package pq
+
+import "iter"
+
+// All returns an iterator over a snapshot of the queue in priority order.
+// The snapshot is taken when iteration starts; the queue is not modified,
+// and breaking early only pays for the elements actually visited.
+func (pq *PriorityQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		snapshot := pq.snapshot()
+		for len(snapshot.table) > 0 {
+			if !yield(snapshot.removeAt(0)) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns a copy of the elements in priority order without
+// modifying the queue.
+func (pq *PriorityQueue[T]) Sorted() []T {
+	snapshot := pq.snapshot()
+	values := make([]T, 0, len(snapshot.table))
+	for len(snapshot.table) > 0 {
+		values = append(values, snapshot.removeAt(0))
+	}
+	return values
+}
+
+// Drain returns an iterator that pops elements in priority order until the
+// queue is empty or the loop breaks. Each step pops under its own lock
+// acquisition, so elements pushed concurrently may be yielded as well.
+func (pq *PriorityQueue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			pq.mu.Lock()
+			if len(pq.table) == 0 {
+				pq.mu.Unlock()
+				return
+			}
+			top := pq.removeAt(0)
+			pq.mu.Unlock()
+
+			if !yield(top) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns an unshared copy of the heap that the caller may consume
+// without locking. Handles are dropped so the original's stay untouched.
+func (pq *PriorityQueue[T]) snapshot() *PriorityQueue[T] {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+
+	table := make([]entry[T], len(pq.table))
+	for i, e := range pq.table {
+		table[i] = entry[T]{value: e.value, seq: e.seq}
+	}
+	return &PriorityQueue[T]{
+		table:    table,
+		compare:  pq.compare,
+		arity:    pq.arity,
+		stable:   pq.stable,
+		reserved: len(table), // consumed once, so never worth shrinking
+	}
+}
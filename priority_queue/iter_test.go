@@ -0,0 +1,111 @@
+package pq
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityQueueSorted(t *testing.T) {
+	pq := NewMaxIntPQ(10, 25, 12, 8, 41)
+	assert.Equal(t, []int{41, 25, 12, 10, 8}, pq.Sorted())
+	assert.Equal(t, 5, pq.Size(), "Sorted must not consume the queue")
+	assert.Equal(t, 41, pq.Pop())
+
+	assert.Empty(t, NewMinIntPQ().Sorted())
+}
+
+func TestPriorityQueueSortedKeepsHandles(t *testing.T) {
+	pq := NewMinIntPQ(5, 1)
+	h := pq.PushHandle(3)
+	assert.Equal(t, []int{1, 3, 5}, pq.Sorted())
+
+	require.NoError(t, pq.Update(h, 0))
+	assert.Equal(t, 0, pq.Pop())
+}
+
+func TestPriorityQueueSortedStable(t *testing.T) {
+	type job struct{ priority, id int }
+	pq := NewStablePriorityQueue(func(a, b job) bool { return a.priority < b.priority },
+		job{1, 0}, job{0, 1}, job{1, 2}, job{0, 3})
+
+	var ids []int
+	for _, j := range pq.Sorted() {
+		ids = append(ids, j.id)
+	}
+	assert.Equal(t, []int{1, 3, 0, 2}, ids)
+}
+
+func TestPriorityQueueAll(t *testing.T) {
+	values := rand.New(rand.NewSource(9)).Perm(100)
+	pq := NewMinIntPQ(values...)
+
+	assert.Equal(t, pq.Sorted(), slices.Collect(pq.All()))
+
+	var firstThree []int
+	for v := range pq.All() {
+		firstThree = append(firstThree, v)
+		if len(firstThree) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 1, 2}, firstThree)
+	assert.Equal(t, 100, pq.Size())
+
+	// The snapshot is unaffected by changes made while iterating
+	count := 0
+	for range pq.All() {
+		pq.Push(-1)
+		count++
+	}
+	assert.Equal(t, 100, count)
+	assert.Equal(t, 200, pq.Size())
+}
+
+func TestPriorityQueueDrain(t *testing.T) {
+	pq := NewMaxIntPQ(3, 1, 4, 1, 5)
+	assert.Equal(t, []int{5, 4, 3, 1, 1}, slices.Collect(pq.Drain()))
+	assert.True(t, pq.Empty())
+
+	pq = NewMinIntPQ(3, 1, 2)
+	for v := range pq.Drain() {
+		assert.Equal(t, 1, v)
+		break
+	}
+	assert.Equal(t, 2, pq.Size(), "breaking early leaves the rest queued")
+}
+
+func TestPriorityQueueIterConcurrent(t *testing.T) {
+	pq := NewMinIntPQ()
+	var wg sync.WaitGroup
+	for i := range 5 {
+		wg.Add(3)
+		go func(base int) {
+			defer wg.Done()
+			for j := range 200 {
+				pq.Push(base*200 + j)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			sorted := pq.Sorted()
+			assert.True(t, slices.IsSorted(sorted))
+		}()
+		go func() {
+			defer wg.Done()
+			// Concurrent pushes may yield smaller values later on,
+			// so only check that draining terminates cleanly.
+			drained := 0
+			for range pq.Drain() {
+				if drained++; drained == 100 {
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
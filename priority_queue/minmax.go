@@ -0,0 +1,241 @@
+package pq
+
+import (
+	"cmp"
+	"math/bits"
+	"sync"
+)
+
+// MinMaxHeap is a thread-safe double-ended priority queue backed by a
+// min-max heap. Both the smallest and the largest element, as ordered by
+// the comparator, can be inspected in O(1) and removed in O(log n).
+type MinMaxHeap[T any] struct {
+	table   []T
+	compare Comparable[T]
+	mu      sync.RWMutex
+}
+
+// NewMinMaxHeap initializes a MinMaxHeap where compFunc(a, b) reports
+// whether a orders before b.
+func NewMinMaxHeap[T any](compFunc Comparable[T], elements ...T) *MinMaxHeap[T] {
+	h := &MinMaxHeap[T]{
+		table:   append([]T{}, elements...),
+		compare: compFunc,
+	}
+	for i := len(h.table)/2 - 1; i >= 0; i-- {
+		h.trickleDown(i)
+	}
+	return h
+}
+
+// NewMinMaxPQ initializes a MinMaxHeap for any ordered type.
+func NewMinMaxPQ[T cmp.Ordered](elements ...T) *MinMaxHeap[T] {
+	return NewMinMaxHeap(cmp.Less[T], elements...)
+}
+
+// Push inserts a new element while maintaining the min-max heap property.
+func (h *MinMaxHeap[T]) Push(data T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.table = append(h.table, data)
+	h.pushUp(len(h.table) - 1)
+}
+
+// PeekMin returns the smallest element without removing it.
+func (h *MinMaxHeap[T]) PeekMin() (T, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var zero T
+	if len(h.table) == 0 {
+		return zero, false
+	}
+	return h.table[0], true
+}
+
+// PeekMax returns the largest element without removing it.
+func (h *MinMaxHeap[T]) PeekMax() (T, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var zero T
+	if len(h.table) == 0 {
+		return zero, false
+	}
+	return h.table[h.maxIndex()], true
+}
+
+// PopMin removes and returns the smallest element.
+// Returns the zero value of the type if the heap is empty.
+func (h *MinMaxHeap[T]) PopMin() T {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var zero T
+	if len(h.table) == 0 {
+		return zero
+	}
+	return h.removeAt(0)
+}
+
+// PopMax removes and returns the largest element.
+// Returns the zero value of the type if the heap is empty.
+func (h *MinMaxHeap[T]) PopMax() T {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var zero T
+	if len(h.table) == 0 {
+		return zero
+	}
+	return h.removeAt(h.maxIndex())
+}
+
+// Size returns the number of elements.
+func (h *MinMaxHeap[T]) Size() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.table)
+}
+
+// Empty returns true if the heap is empty.
+func (h *MinMaxHeap[T]) Empty() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.table) == 0
+}
+
+// Clear removes all elements.
+func (h *MinMaxHeap[T]) Clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.table = nil
+}
+
+// --- Private methods (assume caller has lock) ---
+//
+// Even levels (the root is level 0) are min levels: each node there is no
+// greater than any of its descendants. Odd levels are max levels.
+
+func isMinLevel(index int) bool {
+	return bits.Len(uint(index+1))%2 == 1
+}
+
+func (h *MinMaxHeap[T]) swap(i, j int) {
+	h.table[i], h.table[j] = h.table[j], h.table[i]
+}
+
+// maxIndex returns the index of the largest element of a non-empty heap.
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch {
+	case len(h.table) == 1:
+		return 0
+	case len(h.table) == 2 || h.compare(h.table[2], h.table[1]):
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (h *MinMaxHeap[T]) removeAt(index int) T {
+	lastIndex := len(h.table) - 1
+	removed := h.table[index]
+	h.table[index] = h.table[lastIndex]
+	var zero T
+	h.table[lastIndex] = zero // release the reference for the GC
+	h.table = h.table[:lastIndex]
+	if index < lastIndex {
+		h.trickleDown(index)
+	}
+	return removed
+}
+
+func (h *MinMaxHeap[T]) pushUp(index int) {
+	if index == 0 {
+		return
+	}
+	parent := (index - 1) / 2
+	if isMinLevel(index) {
+		if h.compare(h.table[parent], h.table[index]) {
+			h.swap(index, parent)
+			h.pushUpLevel(parent, h.after)
+		} else {
+			h.pushUpLevel(index, h.compare)
+		}
+	} else {
+		if h.compare(h.table[index], h.table[parent]) {
+			h.swap(index, parent)
+			h.pushUpLevel(parent, h.compare)
+		} else {
+			h.pushUpLevel(index, h.after)
+		}
+	}
+}
+
+// pushUpLevel moves the element at index up through its grandparents while
+// it orders before them according to before.
+func (h *MinMaxHeap[T]) pushUpLevel(index int, before Comparable[T]) {
+	for index > 2 {
+		grandparent := ((index-1)/2 - 1) / 2
+		if !before(h.table[index], h.table[grandparent]) {
+			return
+		}
+		h.swap(index, grandparent)
+		index = grandparent
+	}
+}
+
+func (h *MinMaxHeap[T]) trickleDown(index int) {
+	if isMinLevel(index) {
+		h.trickleDownLevel(index, h.compare)
+	} else {
+		h.trickleDownLevel(index, h.after)
+	}
+}
+
+// trickleDownLevel restores the heap below index, where before orders the
+// elements that belong on index's level ahead of their descendants.
+func (h *MinMaxHeap[T]) trickleDownLevel(index int, before Comparable[T]) {
+	for {
+		m, isGrandchild := h.extremeDescendant(index, before)
+		if m < 0 || !before(h.table[m], h.table[index]) {
+			return
+		}
+		h.swap(m, index)
+		if !isGrandchild {
+			return
+		}
+		if parent := (m - 1) / 2; before(h.table[parent], h.table[m]) {
+			h.swap(m, parent)
+		}
+		index = m
+	}
+}
+
+// extremeDescendant returns the child or grandchild of index that orders
+// first according to before, or -1 if index is a leaf.
+func (h *MinMaxHeap[T]) extremeDescendant(index int, before Comparable[T]) (int, bool) {
+	best, bestIsGrandchild := -1, false
+	firstChild := 2*index + 1
+	for child := firstChild; child <= firstChild+1 && child < len(h.table); child++ {
+		if best < 0 || before(h.table[child], h.table[best]) {
+			best, bestIsGrandchild = child, false
+		}
+		firstGrandchild := 2*child + 1
+		for gc := firstGrandchild; gc <= firstGrandchild+1 && gc < len(h.table); gc++ {
+			if before(h.table[gc], h.table[best]) {
+				best, bestIsGrandchild = gc, true
+			}
+		}
+	}
+	return best, bestIsGrandchild
+}
+
+// after is the comparator reversed, used on max levels.
+func (h *MinMaxHeap[T]) after(a, b T) bool {
+	return h.compare(b, a)
+}
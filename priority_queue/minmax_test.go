@@ -0,0 +1,141 @@
+package pq
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMinMaxHeap(t *testing.T) {
+	h := NewMinMaxPQ[int]()
+	assert.True(t, h.Empty())
+	_, ok := h.PeekMin()
+	assert.False(t, ok)
+	_, ok = h.PeekMax()
+	assert.False(t, ok)
+	assert.Equal(t, 0, h.PopMin())
+	assert.Equal(t, 0, h.PopMax())
+
+	h = NewMinMaxPQ(5, 3, 8, 1, 9, 2)
+	assert.Equal(t, 6, h.Size())
+	v, _ := h.PeekMin()
+	assert.Equal(t, 1, v)
+	v, _ = h.PeekMax()
+	assert.Equal(t, 9, v)
+}
+
+func TestMinMaxHeapSmall(t *testing.T) {
+	h := NewMinMaxPQ[int]()
+	h.Push(1)
+	v, _ := h.PeekMax()
+	assert.Equal(t, 1, v)
+
+	h.Push(2)
+	v, _ = h.PeekMax()
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 2, h.PopMax())
+	assert.Equal(t, 1, h.PopMax())
+	assert.True(t, h.Empty())
+}
+
+func TestMinMaxHeapAlternatingPops(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	values := r.Perm(500)
+	h := NewMinMaxPQ[int]()
+	for _, v := range values {
+		h.Push(v)
+	}
+
+	lo, hi := 0, len(values)-1
+	for !h.Empty() {
+		if r.Intn(2) == 0 {
+			require.Equal(t, lo, h.PopMin())
+			lo++
+		} else {
+			require.Equal(t, hi, h.PopMax())
+			hi--
+		}
+	}
+	assert.Greater(t, lo, hi)
+}
+
+func TestMinMaxHeapBuild(t *testing.T) {
+	values := rand.New(rand.NewSource(7)).Perm(300)
+	h := NewMinMaxPQ(values...)
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	for _, want := range sorted {
+		require.Equal(t, want, h.PopMin())
+	}
+}
+
+func TestMinMaxHeapCustomComparator(t *testing.T) {
+	type job struct {
+		name     string
+		priority int
+	}
+	h := NewMinMaxHeap(func(a, b job) bool { return a.priority < b.priority })
+	h.Push(job{"low", 1})
+	h.Push(job{"high", 9})
+	h.Push(job{"mid", 5})
+
+	assert.Equal(t, "high", h.PopMax().name)
+	assert.Equal(t, "low", h.PopMin().name)
+	assert.Equal(t, "mid", h.PopMax().name)
+
+	h.Push(job{"x", 1})
+	h.Clear()
+	assert.True(t, h.Empty())
+}
+
+func TestMinMaxHeapDuplicates(t *testing.T) {
+	h := NewMinMaxPQ(3, 3, 1, 1, 2, 2)
+	assert.Equal(t, 3, h.PopMax())
+	assert.Equal(t, 3, h.PopMax())
+	assert.Equal(t, 1, h.PopMin())
+	assert.Equal(t, 1, h.PopMin())
+	assert.Equal(t, 2, h.PopMax())
+	assert.Equal(t, 2, h.PopMin())
+}
+
+func TestMinMaxHeapConcurrentAccess(t *testing.T) {
+	h := NewMinMaxPQ[int]()
+	var wg sync.WaitGroup
+	numGoroutines := 10
+	opsPerGoroutine := 500
+
+	for i := range numGoroutines {
+		wg.Add(1)
+		go func(base int) {
+			defer wg.Done()
+			for j := range opsPerGoroutine {
+				h.Push(base*opsPerGoroutine + j)
+			}
+		}(i)
+	}
+	for range numGoroutines / 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range opsPerGoroutine / 2 {
+				_ = h.PopMin()
+				_ = h.PopMax()
+				_, _ = h.PeekMin()
+				_, _ = h.PeekMax()
+			}
+		}()
+	}
+	wg.Wait()
+
+	prev := -1
+	for !h.Empty() {
+		v := h.PopMin()
+		require.GreaterOrEqual(t, v, prev)
+		prev = v
+	}
+}
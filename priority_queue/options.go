@@ -0,0 +1,47 @@
+package pq
+
+// Option configures the heap behind a PriorityQueue and the queues built on
+// it (DelayQueue, AgingQueue and IndexedPriorityQueue).
+type Option func(*options)
+
+type options struct {
+	arity  int  // children per node
+	stable bool // break ties by insertion order
+}
+
+// WithArity selects a d-ary heap with arity children per node. Wider heaps
+// (4 or 8) are shallower, which speeds up Push at the cost of more
+// comparisons per Pop. It panics if arity is less than 2.
+func WithArity(arity int) Option {
+	if arity < 2 {
+		panic("pq: heap arity must be at least 2")
+	}
+	return func(o *options) {
+		o.arity = arity
+	}
+}
+
+// WithStable pops elements of equal priority in insertion order. Two
+// elements tie when neither compares before the other; Update and Fix keep
+// an element's original insertion order.
+func WithStable() Option {
+	return func(o *options) {
+		o.stable = true
+	}
+}
+
+// buildOptions applies opts over the defaults: a binary, unstable heap.
+func buildOptions(opts []Option) options {
+	o := options{arity: 2}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// stableOptions is buildOptions with stable ordering always enabled.
+func stableOptions(opts []Option) options {
+	o := buildOptions(opts)
+	o.stable = true
+	return o
+}
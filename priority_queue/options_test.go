@@ -0,0 +1,59 @@
+package pq
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPriorityQueueWith(t *testing.T) {
+	type job struct{ priority, id int }
+	less := func(a, b job) bool { return a.priority < b.priority }
+
+	pq := NewPriorityQueueWith(less, WithArity(4), WithStable())
+	assert.Equal(t, 4, pq.arity)
+	assert.True(t, pq.stable)
+
+	// Stable ties in a 4-ary heap
+	r := rand.New(rand.NewSource(7))
+	var jobs []job
+	for id := range 200 {
+		jobs = append(jobs, job{priority: r.Intn(5), id: id})
+	}
+	pq.PushAll(jobs...)
+	last := job{priority: -1}
+	for !pq.Empty() {
+		j := pq.Pop()
+		require.True(t, j.priority > last.priority || (j.priority == last.priority && j.id > last.id),
+			"%v popped after %v", j, last)
+		last = j
+	}
+
+	defaults := NewPriorityQueueWith(less)
+	assert.Equal(t, 2, defaults.arity)
+	assert.False(t, defaults.stable)
+
+	assert.Panics(t, func() { WithArity(1) })
+}
+
+func TestOptionsOnDerivedQueues(t *testing.T) {
+	dq := NewDelayQueue[int](newFakeClock(), WithArity(8))
+	assert.Equal(t, 8, dq.heap.arity)
+	assert.True(t, dq.heap.stable, "delay queues are always stable")
+
+	aq := NewAgingQueue(LinearAging(func(v int) float64 { return float64(v) }, 1), 0, newFakeClock(), WithArity(4))
+	assert.Equal(t, 4, aq.heap.arity)
+	assert.True(t, aq.heap.stable, "aging queues are always stable")
+
+	ipq := NewMinIndexedPQ[string, int](WithArity(4), WithStable())
+	assert.Equal(t, 4, ipq.heap.arity)
+	assert.True(t, ipq.heap.stable)
+	ipq.Set("a", 3)
+	ipq.Set("b", 1)
+	ipq.Set("c", 1)
+	key, _, ok := ipq.PopKey()
+	require.True(t, ok)
+	assert.Equal(t, "b", key)
+}
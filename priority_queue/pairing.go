@@ -0,0 +1,174 @@
+package pq
+
+import (
+	"cmp"
+	"sync"
+
+	"github.com/ckshitij/collection/internal/lockorder"
+)
+
+// PairingHeap is a thread-safe pointer-based priority queue whose Meld
+// operation runs in O(1), making it suited to workloads that combine queues
+// frequently. Push and Peek are O(1); Pop is O(log n) amortized.
+type PairingHeap[T any] struct {
+	root    *pairingNode[T]
+	size    int
+	compare Comparable[T]
+	order   lockorder.ID // orders locking in Meld
+	mu      sync.RWMutex
+}
+
+// pairingNode stores its children as a singly linked list of siblings.
+type pairingNode[T any] struct {
+	value   T
+	child   *pairingNode[T]
+	sibling *pairingNode[T]
+}
+
+// NewPairingHeap initializes a new thread-safe PairingHeap.
+func NewPairingHeap[T any](compFunc Comparable[T], elements ...T) *PairingHeap[T] {
+	h := &PairingHeap[T]{compare: compFunc}
+	for _, e := range elements {
+		h.root = h.meld(h.root, &pairingNode[T]{value: e})
+	}
+	h.size = len(elements)
+	return h
+}
+
+// NewMinPairingHeap creates a min-priority PairingHeap for any ordered type.
+func NewMinPairingHeap[T cmp.Ordered](elements ...T) *PairingHeap[T] {
+	return NewPairingHeap(cmp.Less[T], elements...)
+}
+
+// NewMaxPairingHeap creates a max-priority PairingHeap for any ordered type.
+func NewMaxPairingHeap[T cmp.Ordered](elements ...T) *PairingHeap[T] {
+	return NewPairingHeap(func(a, b T) bool {
+		return cmp.Less(b, a)
+	}, elements...)
+}
+
+// Push inserts a new element.
+func (h *PairingHeap[T]) Push(data T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.root = h.meld(h.root, &pairingNode[T]{value: data})
+	h.size++
+}
+
+// Pop removes and returns the element with the highest priority.
+// Returns the zero value of the type if the heap is empty.
+func (h *PairingHeap[T]) Pop() T {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var zero T
+	if h.root == nil {
+		return zero
+	}
+	top := h.root.value
+	h.root = h.mergePairs(h.root.child)
+	h.size--
+	return top
+}
+
+// Peek returns the highest-priority element without removing it.
+func (h *PairingHeap[T]) Peek() (T, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var zero T
+	if h.root == nil {
+		return zero, false
+	}
+	return h.root.value, true
+}
+
+// Meld moves every element of other into h in O(1) and leaves other empty.
+// Both heaps are expected to order elements the same way; h's comparator
+// is used for the result.
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if other == nil || other == h {
+		return
+	}
+	unlock := lockorder.Lock(&h.order, &h.mu, &other.order, &other.mu)
+	defer unlock()
+
+	h.root = h.meld(h.root, other.root)
+	h.size += other.size
+	other.root = nil
+	other.size = 0
+}
+
+// Size returns the number of elements.
+func (h *PairingHeap[T]) Size() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.size
+}
+
+// Empty returns true if the heap is empty.
+func (h *PairingHeap[T]) Empty() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.root == nil
+}
+
+// Clear removes all elements.
+func (h *PairingHeap[T]) Clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.root = nil
+	h.size = 0
+}
+
+// --- Private methods (assume caller has lock) ---
+
+// meld links two roots, making the lower-priority one the first child of the other.
+func (h *PairingHeap[T]) meld(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.compare(b.value, a.value) {
+		a, b = b, a
+	}
+	b.sibling = a.child
+	a.child = b
+	return a
+}
+
+// mergePairs performs the standard two-pass merge of a sibling list:
+// meld adjacent pairs left to right, then fold the results right to left.
+// It is iterative so that long sibling lists cannot exhaust the stack.
+func (h *PairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	var pairs *pairingNode[T] // melded pairs, chained in reverse via sibling
+	for first != nil {
+		a := first
+		b := a.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.sibling = nil
+		}
+		a.sibling = nil
+		pair := h.meld(a, b)
+		pair.sibling = pairs
+		pairs = pair
+	}
+
+	var root *pairingNode[T]
+	for pairs != nil {
+		next := pairs.sibling
+		pairs.sibling = nil
+		root = h.meld(root, pairs)
+		pairs = next
+	}
+	return root
+}
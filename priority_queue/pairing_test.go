@@ -0,0 +1,114 @@
+package pq
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPairingHeap(t *testing.T) {
+	h := NewMinPairingHeap[int]()
+	assert.True(t, h.Empty())
+	assert.Equal(t, 0, h.Pop())
+	_, ok := h.Peek()
+	assert.False(t, ok)
+
+	h = NewMinPairingHeap(5, 3, 8, 1)
+	assert.Equal(t, 4, h.Size())
+	top, ok := h.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, top)
+}
+
+func TestPairingHeapOrder(t *testing.T) {
+	values := rand.New(rand.NewSource(3)).Perm(1000)
+	h := NewMaxPairingHeap(values...)
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	slices.Reverse(sorted)
+	for _, want := range sorted {
+		require.Equal(t, want, h.Pop())
+	}
+	assert.True(t, h.Empty())
+	assert.Equal(t, 0, h.Size())
+}
+
+func TestPairingHeapInterleaved(t *testing.T) {
+	h := NewMinPairingHeap[int]()
+	h.Push(5)
+	h.Push(2)
+	assert.Equal(t, 2, h.Pop())
+	h.Push(1)
+	h.Push(7)
+	assert.Equal(t, 1, h.Pop())
+	assert.Equal(t, 5, h.Pop())
+	assert.Equal(t, 7, h.Pop())
+}
+
+func TestPairingHeapMeld(t *testing.T) {
+	a := NewMinPairingHeap(5, 1, 9)
+	b := NewMinPairingHeap(4, 8, 2)
+
+	a.Meld(b)
+	assert.Equal(t, 6, a.Size())
+	assert.True(t, b.Empty())
+	for _, want := range []int{1, 2, 4, 5, 8, 9} {
+		assert.Equal(t, want, a.Pop())
+	}
+
+	a.Push(3)
+	a.Meld(nil)
+	a.Meld(a)
+	a.Meld(NewMinPairingHeap[int]())
+	assert.Equal(t, 1, a.Size())
+
+	a.Clear()
+	assert.True(t, a.Empty())
+}
+
+func TestPairingHeapConcurrentMeld(t *testing.T) {
+	a := NewMinPairingHeap[int]()
+	b := NewMinPairingHeap[int]()
+	var wg sync.WaitGroup
+
+	for i := range 100 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			a.Push(i)
+			a.Meld(b)
+			_ = a.Pop()
+		}()
+		go func() {
+			defer wg.Done()
+			b.Push(i + 100)
+			b.Meld(a)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 100, a.Size()+b.Size())
+}
+
+func BenchmarkMerge(b *testing.B) {
+	const size = 1024
+	values := rand.New(rand.NewSource(1)).Perm(size)
+
+	b.Run("PriorityQueue", func(b *testing.B) {
+		dst := NewMinIntPQ()
+		for i := 0; i < b.N; i++ {
+			dst.Merge(NewMinIntPQ(values...))
+		}
+	})
+	b.Run("PairingHeap", func(b *testing.B) {
+		dst := NewMinPairingHeap[int]()
+		for i := 0; i < b.N; i++ {
+			dst.Meld(NewMinPairingHeap(values...))
+		}
+	})
+}
@@ -1,24 +1,88 @@
 package pq
 
 import (
+	"context"
+	"errors"
+	"math/bits"
+	"slices"
 	"sync"
+	"sync/atomic"
+
+	"github.com/ckshitij/collection/internal/lockorder"
+	"github.com/ckshitij/collection/internal/signal"
 )
 
 // Comparable defines a function type for comparing two elements of type T.
 type Comparable[T any] func(a T, b T) bool
 
+// ErrInvalidHandle is returned when a handle does not refer to an element
+// currently held by the queue.
+var ErrInvalidHandle = errors.New("handle does not refer to an element in this queue")
+
 // PriorityQueue represents a thread-safe generic priority queue backed by a heap.
 type PriorityQueue[T any] struct {
-	table   []T
-	compare Comparable[T]
-	mu      sync.RWMutex
+	table    []entry[T]
+	compare  Comparable[T]
+	arity    int           // children per node
+	stable   bool          // break ties by insertion order
+	nextSeq  uint64        // sequence number for the next inserted entry
+	pushed   signal.Signal // wakes PopWait callers
+	reserved int           // capacity floor requested through Grow
+	order    lockorder.ID  // orders locking in Merge
+	mu       sync.RWMutex
+}
+
+// Handle tracks the position of an element pushed with PushHandle so it can
+// later be updated or removed in O(log n).
+type Handle[T any] struct {
+	queue atomic.Pointer[PriorityQueue[T]] // owning queue; changes only on Merge
+	index int                              // heap index, -1 once removed; guarded by queue.mu
+}
+
+// entry is a heap slot: the element, its insertion sequence number and its
+// optional handle.
+type entry[T any] struct {
+	value  T
+	seq    uint64
+	handle *Handle[T]
 }
 
 // NewPriorityQueue initializes a new thread-safe PriorityQueue.
 func NewPriorityQueue[T any](compFunc Comparable[T], elements ...T) *PriorityQueue[T] {
+	return newPriorityQueue(compFunc, buildOptions(nil), elements)
+}
+
+// NewPriorityQueueWith initializes an empty thread-safe PriorityQueue
+// configured by opts, e.g. WithArity(4) and WithStable(). Use PushAll to
+// add initial elements.
+func NewPriorityQueueWith[T any](compFunc Comparable[T], opts ...Option) *PriorityQueue[T] {
+	return newPriorityQueue(compFunc, buildOptions(opts), nil)
+}
+
+// NewDaryPriorityQueue initializes a thread-safe PriorityQueue backed by a
+// d-ary heap; it is shorthand for WithArity(arity).
+// It panics if arity is less than 2.
+func NewDaryPriorityQueue[T any](arity int, compFunc Comparable[T], elements ...T) *PriorityQueue[T] {
+	return newPriorityQueue(compFunc, buildOptions([]Option{WithArity(arity)}), elements)
+}
+
+// NewStablePriorityQueue initializes a thread-safe PriorityQueue that pops
+// elements of equal priority in insertion order; it is shorthand for
+// WithStable(). The initial elements are inserted in the order given.
+func NewStablePriorityQueue[T any](compFunc Comparable[T], elements ...T) *PriorityQueue[T] {
+	return newPriorityQueue(compFunc, buildOptions([]Option{WithStable()}), elements)
+}
+
+func newPriorityQueue[T any](compFunc Comparable[T], o options, elements []T) *PriorityQueue[T] {
 	q := &PriorityQueue[T]{
-		table:   append([]T{}, elements...),
+		table:   make([]entry[T], len(elements)),
 		compare: compFunc,
+		arity:   o.arity,
+		stable:  o.stable,
+	}
+	for i, e := range elements {
+		q.table[i] = entry[T]{value: e, seq: q.nextSeq}
+		q.nextSeq++
 	}
 	q.buildHeap()
 	return q
@@ -29,8 +93,33 @@ func (pq *PriorityQueue[T]) Push(data T) {
 	pq.mu.Lock()
 	defer pq.mu.Unlock()
 
-	pq.table = append(pq.table, data)
-	pq.siftUp(len(pq.table) - 1)
+	pq.push(entry[T]{value: data})
+}
+
+// PushAll inserts every item under a single lock acquisition. Large batches
+// are appended and the heap is rebuilt in O(n) when that is cheaper than
+// sifting each item up individually.
+func (pq *PriorityQueue[T]) PushAll(items ...T) {
+	if len(items) == 0 {
+		return
+	}
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	entries := make([]entry[T], len(items))
+	for i, item := range items {
+		entries[i] = entry[T]{value: item, seq: pq.nextSeq}
+		pq.nextSeq++
+	}
+	pq.pushEntries(entries)
+}
+
+// PushHandle inserts a new element and returns a handle for Update, Fix and Remove.
+func (pq *PriorityQueue[T]) PushHandle(data T) *Handle[T] {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	return pq.pushHandle(data)
 }
 
 // Pop removes and returns the element with the highest priority.
@@ -42,12 +131,43 @@ func (pq *PriorityQueue[T]) Pop() T {
 	if len(pq.table) == 0 {
 		return zero
 	}
-	top := pq.table[0]
-	lastIndex := len(pq.table) - 1
-	pq.swap(0, lastIndex)
-	pq.table = pq.table[:lastIndex]
-	pq.heapify(0)
-	return top
+	return pq.removeAt(0)
+}
+
+// PopN removes and returns up to n elements in priority order under a
+// single lock acquisition. It returns fewer than n if the queue runs out.
+func (pq *PriorityQueue[T]) PopN(n int) []T {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	n = min(max(n, 0), len(pq.table))
+	values := make([]T, n)
+	for i := range values {
+		values[i] = pq.removeAt(0)
+	}
+	return values
+}
+
+// PopWait removes and returns the element with the highest priority,
+// blocking until one is available or ctx is done (ctx.Err()).
+func (pq *PriorityQueue[T]) PopWait(ctx context.Context) (T, error) {
+	var zero T
+	for {
+		pq.mu.Lock()
+		if len(pq.table) > 0 {
+			top := pq.removeAt(0)
+			pq.mu.Unlock()
+			return top, nil
+		}
+		ready := pq.pushed.Wait()
+		pq.mu.Unlock()
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
 }
 
 // Peek returns the highest-priority element without removing it.
@@ -59,7 +179,116 @@ func (pq *PriorityQueue[T]) Peek() (T, bool) {
 	if len(pq.table) == 0 {
 		return zero, false
 	}
-	return pq.table[0], true
+	return pq.table[0].value, true
+}
+
+// Update replaces the element referenced by handle and restores heap order.
+func (pq *PriorityQueue[T]) Update(handle *Handle[T], data T) error {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	if !pq.owns(handle) {
+		return ErrInvalidHandle
+	}
+	pq.table[handle.index].value = data
+	pq.fix(handle.index)
+	return nil
+}
+
+// Fix restores heap order after the element referenced by handle has been
+// modified in place, e.g. through a pointer.
+func (pq *PriorityQueue[T]) Fix(handle *Handle[T]) error {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	if !pq.owns(handle) {
+		return ErrInvalidHandle
+	}
+	pq.fix(handle.index)
+	return nil
+}
+
+// Remove deletes the element referenced by handle and returns it.
+func (pq *PriorityQueue[T]) Remove(handle *Handle[T]) (T, error) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	var zero T
+	if !pq.owns(handle) {
+		return zero, ErrInvalidHandle
+	}
+	return pq.removeAt(handle.index), nil
+}
+
+// Value returns the element referenced by handle.
+// The boolean is false if the element is no longer in the queue.
+func (pq *PriorityQueue[T]) Value(handle *Handle[T]) (T, bool) {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+
+	var zero T
+	if !pq.owns(handle) {
+		return zero, false
+	}
+	return pq.table[handle.index].value, true
+}
+
+// Merge moves every element of other into pq and leaves other empty.
+// It costs at most O(n), rebuilding the combined heap unless sifting the
+// incoming elements up is cheaper. Handles obtained from other remain
+// valid and now refer to pq. In stable mode, elements from other keep their
+// relative insertion order and rank after the elements already in pq.
+// Both queues are expected to order elements the same way; pq's comparator
+// is used for the result.
+func (pq *PriorityQueue[T]) Merge(other *PriorityQueue[T]) {
+	if other == nil || other == pq {
+		return
+	}
+	unlock := lockorder.Lock(&pq.order, &pq.mu, &other.order, &other.mu)
+	defer unlock()
+
+	for i := range other.table {
+		e := &other.table[i]
+		e.seq += pq.nextSeq
+		if e.handle != nil {
+			e.handle.queue.Store(pq)
+		}
+	}
+	pq.nextSeq += other.nextSeq
+	pq.pushEntries(other.table)
+	other.reset()
+}
+
+// Grow ensures room for at least n more elements without reallocating.
+// The resulting capacity also acts as a floor for automatic shrinking.
+func (pq *PriorityQueue[T]) Grow(n int) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	if n <= 0 {
+		return
+	}
+	pq.table = slices.Grow(pq.table, n)
+	pq.reserved = max(pq.reserved, cap(pq.table))
+}
+
+// Cap returns the number of elements the queue can hold without reallocating.
+func (pq *PriorityQueue[T]) Cap() int {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+
+	return cap(pq.table)
+}
+
+// Shrink releases all unused capacity, including any reserved by Grow.
+// Queues also shrink automatically once they fall below a quarter of their
+// capacity, but never below what Grow reserved.
+func (pq *PriorityQueue[T]) Shrink() {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	pq.reserved = 0
+	pq.resize(len(pq.table))
 }
 
 // Size returns the number of elements.
@@ -78,15 +307,17 @@ func (pq *PriorityQueue[T]) Empty() bool {
 	return len(pq.table) == 0
 }
 
-// Clear removes all elements.
+// Clear removes all elements, keeping any capacity reserved by Grow.
 func (pq *PriorityQueue[T]) Clear() {
 	pq.mu.Lock()
 	defer pq.mu.Unlock()
 
-	pq.table = nil
+	pq.clear()
 }
 
 // GetValues returns a copy slice between start and end indices (inclusive).
+// Indices refer to the underlying heap array, which is not in priority
+// order; use Sorted or All for that.
 func (pq *PriorityQueue[T]) GetValues(startInd, endInd int) []T {
 	pq.mu.RLock()
 	defer pq.mu.RUnlock()
@@ -95,50 +326,65 @@ func (pq *PriorityQueue[T]) GetValues(startInd, endInd int) []T {
 		return []T{}
 	}
 	tempData := make([]T, endInd-startInd+1)
-	copy(tempData, pq.table[startInd:endInd+1])
+	for i := range tempData {
+		tempData[i] = pq.table[startInd+i].value
+	}
 	return tempData
 }
 
 // --- Private methods (assume caller has lock) ---
 
-func (_ *PriorityQueue[T]) parent(index int) int {
-	return (index - 1) / 2
+func (pq *PriorityQueue[T]) parent(index int) int {
+	return (index - 1) / pq.arity
 }
 
-func (_ *PriorityQueue[T]) left(index int) int {
-	return 2*index + 1
+func (pq *PriorityQueue[T]) firstChild(index int) int {
+	return pq.arity*index + 1
 }
 
-func (_ *PriorityQueue[T]) right(index int) int {
-	return 2*index + 2
+// less reports whether the entry at i has higher priority than the one at j.
+func (pq *PriorityQueue[T]) less(i, j int) bool {
+	a, b := &pq.table[i], &pq.table[j]
+	if pq.compare(a.value, b.value) {
+		return true
+	}
+	if !pq.stable || pq.compare(b.value, a.value) {
+		return false
+	}
+	return a.seq < b.seq
 }
 
 func (pq *PriorityQueue[T]) swap(i, j int) {
 	pq.table[i], pq.table[j] = pq.table[j], pq.table[i]
-}
-
-func (pq *PriorityQueue[T]) heapify(index int) {
-	highest := index
-	left := pq.left(index)
-	right := pq.right(index)
-
-	if left < len(pq.table) && pq.compare(pq.table[left], pq.table[highest]) {
-		highest = left
+	if h := pq.table[i].handle; h != nil {
+		h.index = i
 	}
-	if right < len(pq.table) && pq.compare(pq.table[right], pq.table[highest]) {
-		highest = right
+	if h := pq.table[j].handle; h != nil {
+		h.index = j
 	}
+}
 
-	if highest != index {
+func (pq *PriorityQueue[T]) heapify(index int) {
+	for {
+		highest := index
+		first := pq.firstChild(index)
+		for child := first; child < first+pq.arity && child < len(pq.table); child++ {
+			if pq.less(child, highest) {
+				highest = child
+			}
+		}
+		if highest == index {
+			return
+		}
 		pq.swap(index, highest)
-		pq.heapify(highest)
+		index = highest
 	}
 }
 
 func (pq *PriorityQueue[T]) siftUp(index int) {
 	for index > 0 {
 		parent := pq.parent(index)
-		if pq.compare(pq.table[index], pq.table[parent]) {
+		if pq.less(index, parent) {
 			pq.swap(index, parent)
 			index = parent
 		} else {
@@ -148,7 +394,133 @@ func (pq *PriorityQueue[T]) siftUp(index int) {
 }
 
 func (pq *PriorityQueue[T]) buildHeap() {
-	for i := len(pq.table)/2 - 1; i >= 0; i-- {
+	if len(pq.table) < 2 {
+		return
+	}
+	for i := pq.parent(len(pq.table) - 1); i >= 0; i-- {
 		pq.heapify(i)
 	}
 }
+
+func (pq *PriorityQueue[T]) push(e entry[T]) {
+	e.seq = pq.nextSeq
+	pq.nextSeq++
+	pq.table = append(pq.table, e)
+	index := len(pq.table) - 1
+	if e.handle != nil {
+		e.handle.index = index
+	}
+	pq.siftUp(index)
+	pq.pushed.Broadcast()
+}
+
+// clear drops every entry and invalidates their handles.
+func (pq *PriorityQueue[T]) clear() {
+	for _, e := range pq.table {
+		if e.handle != nil {
+			e.handle.index = -1
+		}
+	}
+	pq.reset()
+}
+
+// reset empties the table, keeping a backing array of the capacity
+// reserved through Grow.
+func (pq *PriorityQueue[T]) reset() {
+	if cap(pq.table) != pq.reserved {
+		pq.table = nil
+		if pq.reserved > 0 {
+			pq.table = make([]entry[T], 0, pq.reserved)
+		}
+		return
+	}
+	clear(pq.table)
+	pq.table = pq.table[:0]
+}
+
+// pushEntries appends entries that already carry sequence numbers and
+// restores heap order with whichever of a full rebuild or per-entry
+// sift-ups needs fewer steps.
+func (pq *PriorityQueue[T]) pushEntries(entries []entry[T]) {
+	if len(entries) == 0 {
+		return
+	}
+	start := len(pq.table)
+	pq.table = append(pq.table, entries...)
+	for i := start; i < len(pq.table); i++ {
+		if h := pq.table[i].handle; h != nil {
+			h.index = i
+		}
+	}
+
+	total := len(pq.table)
+	if len(entries)*bits.Len(uint(total)) > total {
+		pq.buildHeap()
+	} else {
+		for i := start; i < total; i++ {
+			pq.siftUp(i)
+		}
+	}
+	pq.pushed.Broadcast()
+}
+
+func (pq *PriorityQueue[T]) pushHandle(data T) *Handle[T] {
+	handle := &Handle[T]{}
+	handle.queue.Store(pq)
+	pq.push(entry[T]{value: data, handle: handle})
+	return handle
+}
+
+// fix moves the entry at index up or down until heap order holds again.
+func (pq *PriorityQueue[T]) fix(index int) {
+	pq.heapify(index)
+	pq.siftUp(index)
+}
+
+// removeAt deletes the entry at index and returns its value.
+func (pq *PriorityQueue[T]) removeAt(index int) T {
+	lastIndex := len(pq.table) - 1
+	pq.swap(index, lastIndex)
+	removed := pq.table[lastIndex]
+	pq.table[lastIndex] = entry[T]{} // release references for the GC
+	pq.table = pq.table[:lastIndex]
+	if index < lastIndex {
+		pq.fix(index)
+	}
+	if removed.handle != nil {
+		removed.handle.index = -1
+	}
+	pq.shrinkIfSparse()
+	return removed.value
+}
+
+// minShrinkCapacity is the smallest backing array worth shrinking automatically.
+const minShrinkCapacity = 64
+
+// shrinkIfSparse halves the backing array once it is less than a quarter
+// full, keeping at least the capacity reserved through Grow.
+func (pq *PriorityQueue[T]) shrinkIfSparse() {
+	c := cap(pq.table)
+	if c <= minShrinkCapacity || c <= pq.reserved || len(pq.table) > c/4 {
+		return
+	}
+	pq.resize(max(c/2, pq.reserved))
+}
+
+// resize moves the entries into a new backing array of the given capacity.
+func (pq *PriorityQueue[T]) resize(capacity int) {
+	if capacity == cap(pq.table) {
+		return
+	}
+	if capacity == 0 {
+		pq.table = nil
+		return
+	}
+	table := make([]entry[T], len(pq.table), capacity)
+	copy(table, pq.table)
+	pq.table = table
+}
+
+func (pq *PriorityQueue[T]) owns(handle *Handle[T]) bool {
+	return handle != nil && handle.queue.Load() == pq && handle.index >= 0
+}
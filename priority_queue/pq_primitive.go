@@ -1,113 +1,106 @@
 package pq
 
+import "cmp"
+
+// NewMinPQ creates a min-priority queue for any ordered type.
+// NaN values sort before all other floating-point values, as in cmp.Less.
+func NewMinPQ[T cmp.Ordered](elements ...T) *PriorityQueue[T] {
+	return NewPriorityQueue(cmp.Less[T], elements...)
+}
+
+// NewMaxPQ creates a max-priority queue for any ordered type.
+// NaN values sort after all other floating-point values.
+func NewMaxPQ[T cmp.Ordered](elements ...T) *PriorityQueue[T] {
+	return NewPriorityQueue(func(a, b T) bool {
+		return cmp.Less(b, a)
+	}, elements...)
+}
+
+// NewPQFunc creates a priority queue from a three-way comparator such as
+// cmp.Compare or the ones accepted by slices.SortFunc. Elements for which
+// compare returns a negative result have higher priority.
+func NewPQFunc[T any](compare func(a, b T) int, elements ...T) *PriorityQueue[T] {
+	return NewPriorityQueue(func(a, b T) bool {
+		return compare(a, b) < 0
+	}, elements...)
+}
+
 // NewMinIntPQ creates a min-priority queue for integers.
 func NewMinIntPQ(elements ...int) *PriorityQueue[int] {
-	return NewPriorityQueue(func(a, b int) bool {
-		return a < b
-	}, elements...)
+	return NewMinPQ(elements...)
 }
 
 // NewMaxIntPQ creates a max-priority queue for integers.
 func NewMaxIntPQ(elements ...int) *PriorityQueue[int] {
-	return NewPriorityQueue(func(a, b int) bool {
-		return a > b
-	}, elements...)
+	return NewMaxPQ(elements...)
 }
 
 // NewMinInt32PQ creates a min-priority queue for 32 bit integers.
 func NewMinInt32PQ(elements ...int32) *PriorityQueue[int32] {
-	return NewPriorityQueue(func(a, b int32) bool {
-		return a < b
-	}, elements...)
+	return NewMinPQ(elements...)
 }
 
 // NewMaxInt32PQ creates a max-priority queue for 32 bit integers.
 func NewMaxInt32PQ(elements ...int32) *PriorityQueue[int32] {
-	return NewPriorityQueue(func(a, b int32) bool {
-		return a > b
-	}, elements...)
+	return NewMaxPQ(elements...)
 }
 
 // NewMinInt16PQ creates a min-priority queue for 16 bit integers.
 func NewMinInt16PQ(elements ...int16) *PriorityQueue[int16] {
-	return NewPriorityQueue(func(a, b int16) bool {
-		return a < b
-	}, elements...)
+	return NewMinPQ(elements...)
 }
 
 // NewMaxInt16PQ creates a max-priority queue for 16 bit integers.
 func NewMaxInt16PQ(elements ...int16) *PriorityQueue[int16] {
-	return NewPriorityQueue(func(a, b int16) bool {
-		return a > b
-	}, elements...)
+	return NewMaxPQ(elements...)
 }
 
 // NewMinInt8PQ creates a min-priority queue for 8 bit integers.
 func NewMinInt8PQ(elements ...int8) *PriorityQueue[int8] {
-	return NewPriorityQueue(func(a, b int8) bool {
-		return a < b
-	}, elements...)
+	return NewMinPQ(elements...)
 }
 
 // NewMaxInt8PQ creates a max-priority queue for 8 bit integers.
 func NewMaxInt8PQ(elements ...int8) *PriorityQueue[int8] {
-	return NewPriorityQueue(func(a, b int8) bool {
-		return a > b
-	}, elements...)
+	return NewMaxPQ(elements...)
 }
 
 // NewMinInt64PQ creates a min-priority queue for 64 bit integers.
 func NewMinInt64PQ(elements ...int64) *PriorityQueue[int64] {
-	return NewPriorityQueue(func(a, b int64) bool {
-		return a < b
-	}, elements...)
+	return NewMinPQ(elements...)
 }
 
 // NewMaxInt64PQ creates a max-priority queue for 64 bit integers.
 func NewMaxInt64PQ(elements ...int64) *PriorityQueue[int64] {
-	return NewPriorityQueue(func(a, b int64) bool {
-		return a > b
-	}, elements...)
+	return NewMaxPQ(elements...)
 }
 
 // NewMinFloat64PQ creates a min-priority queue for float64 values.
 func NewMinFloat64PQ(elements ...float64) *PriorityQueue[float64] {
-	return NewPriorityQueue(func(a, b float64) bool {
-		return a < b
-	}, elements...)
+	return NewMinPQ(elements...)
 }
 
 // NewMaxFloat64PQ creates a max-priority queue for float64 values.
 func NewMaxFloat64PQ(elements ...float64) *PriorityQueue[float64] {
-	return NewPriorityQueue(func(a, b float64) bool {
-		return a > b
-	}, elements...)
+	return NewMaxPQ(elements...)
 }
 
 // NewMinFloat32PQ creates a min-priority queue for float32 values.
 func NewMinFloat32PQ(elements ...float32) *PriorityQueue[float32] {
-	return NewPriorityQueue(func(a, b float32) bool {
-		return a < b
-	}, elements...)
+	return NewMinPQ(elements...)
 }
 
 // NewMaxFloat32PQ creates a max-priority queue for float32 values.
 func NewMaxFloat32PQ(elements ...float32) *PriorityQueue[float32] {
-	return NewPriorityQueue(func(a, b float32) bool {
-		return a > b
-	}, elements...)
+	return NewMaxPQ(elements...)
 }
 
 // NewMinStringPQ creates a min-priority queue for strings (lexicographically smallest first).
 func NewMinStringPQ(elements ...string) *PriorityQueue[string] {
-	return NewPriorityQueue(func(a, b string) bool {
-		return a < b
-	}, elements...)
+	return NewMinPQ(elements...)
 }
 
 // NewMaxStringPQ creates a max-priority queue for strings (lexicographically largest first).
 func NewMaxStringPQ(elements ...string) *PriorityQueue[string] {
-	return NewPriorityQueue(func(a, b string) bool {
-		return a > b
-	}, elements...)
+	return NewMaxPQ(elements...)
 }
@@ -1,7 +1,11 @@
 package pq
 
 import (
+	"cmp"
+	"math"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -149,3 +153,51 @@ func TestNewMaxStringPQ(t *testing.T) {
 	}
 	assert.True(t, pq.Empty())
 }
+
+func TestNewMinPQ(t *testing.T) {
+	pq := NewMinPQ[uint16](4, 1, 3, 2)
+	for _, v := range []uint16{1, 2, 3, 4} {
+		assert.Equal(t, v, pq.Pop())
+	}
+	assert.True(t, pq.Empty())
+
+	durations := NewMinPQ(time.Second, time.Millisecond, time.Minute)
+	assert.Equal(t, time.Millisecond, durations.Pop())
+	assert.Equal(t, time.Second, durations.Pop())
+	assert.Equal(t, time.Minute, durations.Pop())
+}
+
+func TestNewMaxPQ(t *testing.T) {
+	type score uint64
+	pq := NewMaxPQ[score](4, 1, 3, 2)
+	for _, v := range []score{4, 3, 2, 1} {
+		assert.Equal(t, v, pq.Pop())
+	}
+	assert.True(t, pq.Empty())
+}
+
+func TestOrderedPQNaN(t *testing.T) {
+	minPQ := NewMinPQ(2.0, math.NaN(), 1.0)
+	assert.True(t, math.IsNaN(minPQ.Pop()))
+	assert.Equal(t, 1.0, minPQ.Pop())
+	assert.Equal(t, 2.0, minPQ.Pop())
+
+	maxPQ := NewMaxPQ(2.0, math.NaN(), 1.0)
+	assert.Equal(t, 2.0, maxPQ.Pop())
+	assert.Equal(t, 1.0, maxPQ.Pop())
+	assert.True(t, math.IsNaN(maxPQ.Pop()))
+}
+
+func TestNewPQFunc(t *testing.T) {
+	pq := NewPQFunc(cmp.Compare[int], 4, 1, 3, 2)
+	for _, v := range []int{1, 2, 3, 4} {
+		assert.Equal(t, v, pq.Pop())
+	}
+
+	byLength := NewPQFunc(func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(b), len(a)), strings.Compare(a, b))
+	}, "bb", "a", "ccc", "aa")
+	for _, v := range []string{"ccc", "aa", "bb", "a"} {
+		assert.Equal(t, v, byLength.Pop())
+	}
+}
@@ -1,8 +1,12 @@
 package pq
 
 import (
+	"context"
+	"fmt"
+	"math/rand"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -233,3 +237,514 @@ func TestPriorityQueue_ConcurrentAccess(t *testing.T) {
 
 	require.GreaterOrEqual(t, q.Size(), 0)
 }
+
+func TestPriorityQueueHandleUpdate(t *testing.T) {
+	pq := NewMinIntPQ(5, 10)
+	h := pq.PushHandle(20)
+
+	v, ok := pq.Value(h)
+	assert.True(t, ok)
+	assert.Equal(t, 20, v)
+
+	// Decrease-key moves the element to the top
+	require.NoError(t, pq.Update(h, 1))
+	top, _ := pq.Peek()
+	assert.Equal(t, 1, top)
+
+	// Increase-key sinks it again
+	require.NoError(t, pq.Update(h, 30))
+	assert.Equal(t, 5, pq.Pop())
+	assert.Equal(t, 10, pq.Pop())
+	assert.Equal(t, 30, pq.Pop())
+
+	// Popped handles are invalid
+	assert.Equal(t, ErrInvalidHandle, pq.Update(h, 0))
+	_, ok = pq.Value(h)
+	assert.False(t, ok)
+}
+
+func TestPriorityQueueHandleFix(t *testing.T) {
+	type task struct{ priority int }
+	pq := NewPriorityQueue(func(a, b *task) bool { return a.priority < b.priority })
+	a := &task{priority: 1}
+	b := &task{priority: 2}
+	ha := pq.PushHandle(a)
+	pq.PushHandle(b)
+
+	a.priority = 3
+	require.NoError(t, pq.Fix(ha))
+	assert.Equal(t, b, pq.Pop())
+	assert.Equal(t, a, pq.Pop())
+	assert.Equal(t, ErrInvalidHandle, pq.Fix(ha))
+}
+
+func TestPriorityQueueHandleRemove(t *testing.T) {
+	pq := NewMinIntPQ()
+	handles := make([]*Handle[int], 0, 10)
+	for i := range 10 {
+		handles = append(handles, pq.PushHandle(i))
+	}
+
+	for _, i := range []int{0, 9, 4, 5} {
+		v, err := pq.Remove(handles[i])
+		require.NoError(t, err)
+		assert.Equal(t, i, v)
+	}
+	_, err := pq.Remove(handles[4])
+	assert.Equal(t, ErrInvalidHandle, err)
+
+	for _, want := range []int{1, 2, 3, 6, 7, 8} {
+		assert.Equal(t, want, pq.Pop())
+	}
+	assert.True(t, pq.Empty())
+}
+
+func TestPriorityQueueHandleInvalid(t *testing.T) {
+	a := NewMinIntPQ()
+	b := NewMinIntPQ(1)
+	h := a.PushHandle(1)
+
+	assert.Equal(t, ErrInvalidHandle, b.Update(h, 0))
+	assert.Equal(t, ErrInvalidHandle, b.Fix(h))
+	_, err := b.Remove(h)
+	assert.Equal(t, ErrInvalidHandle, err)
+	assert.Equal(t, ErrInvalidHandle, a.Update(nil, 0))
+
+	a.Clear()
+	assert.Equal(t, ErrInvalidHandle, a.Update(h, 0))
+	assert.Equal(t, 1, b.Size())
+}
+
+func TestPriorityQueueHandleHeapOrder(t *testing.T) {
+	pq := NewMaxIntPQ()
+	handles := make([]*Handle[int], 0, 100)
+	for i := range 100 {
+		handles = append(handles, pq.PushHandle(i))
+	}
+	// Reverse every priority through its handle
+	for i, h := range handles {
+		require.NoError(t, pq.Update(h, -i))
+	}
+	for i := range 100 {
+		assert.Equal(t, -i, pq.Pop())
+	}
+}
+
+func TestStablePriorityQueue(t *testing.T) {
+	type job struct {
+		priority int
+		name     string
+	}
+	comp := func(a, b job) bool { return a.priority < b.priority }
+
+	pq := NewStablePriorityQueue(comp,
+		job{2, "a"}, job{1, "b"}, job{2, "c"}, job{1, "d"}, job{2, "e"})
+	pq.Push(job{1, "f"})
+	pq.Push(job{2, "g"})
+
+	var order []string
+	for !pq.Empty() {
+		order = append(order, pq.Pop().name)
+	}
+	assert.Equal(t, []string{"b", "d", "f", "a", "c", "e", "g"}, order)
+}
+
+func TestStablePriorityQueueManyTies(t *testing.T) {
+	type job struct{ priority, id int }
+	pq := NewStablePriorityQueue(func(a, b job) bool { return a.priority > b.priority })
+	for i := range 1000 {
+		pq.Push(job{priority: i % 3, id: i})
+	}
+
+	lastID := map[int]int{0: -1, 1: -1, 2: -1}
+	lastPriority := 2
+	for !pq.Empty() {
+		j := pq.Pop()
+		assert.LessOrEqual(t, j.priority, lastPriority)
+		assert.Greater(t, j.id, lastID[j.priority], "ties must pop in insertion order")
+		lastID[j.priority] = j.id
+		lastPriority = j.priority
+	}
+}
+
+func TestStablePriorityQueueUpdateKeepsOrder(t *testing.T) {
+	type job struct{ priority, id int }
+	pq := NewStablePriorityQueue(func(a, b job) bool { return a.priority < b.priority })
+	first := pq.PushHandle(job{priority: 5, id: 1})
+	pq.Push(job{priority: 1, id: 2})
+
+	require.NoError(t, pq.Update(first, job{priority: 1, id: 1}))
+	assert.Equal(t, 1, pq.Pop().id)
+	assert.Equal(t, 2, pq.Pop().id)
+}
+
+func TestPriorityQueuePopWait(t *testing.T) {
+	pq := NewMinIntPQ(3)
+	v, err := pq.PopWait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, v)
+
+	result := make(chan int)
+	go func() {
+		v, err := pq.PopWait(context.Background())
+		assert.NoError(t, err)
+		result <- v
+	}()
+
+	select {
+	case <-result:
+		t.Fatal("PopWait returned before an element was pushed")
+	case <-time.After(20 * time.Millisecond):
+	}
+	pq.Push(7)
+	assert.Equal(t, 7, <-result)
+}
+
+func TestPriorityQueuePopWaitContext(t *testing.T) {
+	pq := NewMinIntPQ()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := pq.PopWait(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestPriorityQueuePopWaitConcurrent(t *testing.T) {
+	pq := NewMaxIntPQ()
+	numConsumers := 4
+	total := 1000
+
+	var wg sync.WaitGroup
+	results := make(chan int, total)
+	for range numConsumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range total / numConsumers {
+				v, err := pq.PopWait(context.Background())
+				assert.NoError(t, err)
+				results <- v
+			}
+		}()
+	}
+	for i := range total {
+		pq.Push(i)
+	}
+	wg.Wait()
+	close(results)
+
+	seen := make(map[int]bool)
+	for v := range results {
+		seen[v] = true
+	}
+	assert.Len(t, seen, total)
+	assert.True(t, pq.Empty())
+}
+
+func TestPriorityQueueMerge(t *testing.T) {
+	a := NewMinIntPQ(5, 1, 9)
+	b := NewMinIntPQ(4, 8, 2, 7)
+
+	a.Merge(b)
+	assert.Equal(t, 7, a.Size())
+	assert.True(t, b.Empty())
+	for _, want := range []int{1, 2, 4, 5, 7, 8, 9} {
+		assert.Equal(t, want, a.Pop())
+	}
+
+	// Merging nil, itself or an empty queue is harmless
+	a.Push(3)
+	a.Merge(nil)
+	a.Merge(a)
+	a.Merge(NewMinIntPQ())
+	assert.Equal(t, 1, a.Size())
+
+	// The source queue stays usable
+	b.Push(6)
+	assert.Equal(t, 6, b.Pop())
+}
+
+func TestPriorityQueueMergeHandles(t *testing.T) {
+	a := NewMinIntPQ(10, 20)
+	b := NewMinIntPQ()
+	h := b.PushHandle(30)
+
+	a.Merge(b)
+	assert.Equal(t, ErrInvalidHandle, b.Update(h, 0))
+	require.NoError(t, a.Update(h, 0))
+	assert.Equal(t, 0, a.Pop())
+	assert.Equal(t, ErrInvalidHandle, a.Update(h, 5))
+}
+
+func TestPriorityQueueMergeStable(t *testing.T) {
+	type job struct{ priority, id int }
+	comp := func(x, y job) bool { return x.priority < y.priority }
+	a := NewStablePriorityQueue(comp, job{1, 1}, job{1, 2})
+	b := NewStablePriorityQueue(comp, job{1, 3}, job{0, 0}, job{1, 4})
+
+	a.Merge(b)
+	a.Push(job{1, 5})
+	for want := range 6 {
+		assert.Equal(t, want, a.Pop().id)
+	}
+}
+
+func TestPriorityQueueMergeConcurrent(t *testing.T) {
+	a := NewMinIntPQ()
+	b := NewMinIntPQ()
+	var wg sync.WaitGroup
+
+	// Opposite-direction merges must not deadlock
+	for i := range 100 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			a.Push(i)
+			a.Merge(b)
+		}()
+		go func() {
+			defer wg.Done()
+			b.Push(i + 100)
+			b.Merge(a)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 200, a.Size()+b.Size())
+}
+
+func TestDaryPriorityQueue(t *testing.T) {
+	values := rand.New(rand.NewSource(5)).Perm(500)
+	for _, arity := range []int{2, 3, 4, 8} {
+		t.Run(fmt.Sprintf("arity=%d", arity), func(t *testing.T) {
+			less := func(a, b int) bool { return a < b }
+
+			// Heap built from initial elements
+			pq := NewDaryPriorityQueue(arity, less, values...)
+			for want := range len(values) {
+				require.Equal(t, want, pq.Pop())
+			}
+
+			// Heap built by pushes, with handles
+			pq = NewDaryPriorityQueue[int](arity, less)
+			handles := make([]*Handle[int], len(values))
+			for i, v := range values {
+				handles[i] = pq.PushHandle(v)
+			}
+			for i, h := range handles {
+				require.NoError(t, pq.Update(h, -values[i]))
+			}
+			for want := range len(values) {
+				require.Equal(t, want-len(values)+1, pq.Pop())
+			}
+			assert.True(t, pq.Empty())
+		})
+	}
+
+	assert.Panics(t, func() {
+		NewDaryPriorityQueue(1, func(a, b int) bool { return a < b })
+	})
+}
+
+func BenchmarkDaryPriorityQueue(b *testing.B) {
+	const size = 4096
+	values := rand.New(rand.NewSource(1)).Perm(size)
+	less := func(a, b int) bool { return a < b }
+
+	for _, arity := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("Push/arity=%d", arity), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				pq := NewDaryPriorityQueue[int](arity, less)
+				for _, v := range values {
+					pq.Push(v)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("PushPop/arity=%d", arity), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				pq := NewDaryPriorityQueue[int](arity, less)
+				for _, v := range values {
+					pq.Push(v)
+				}
+				for range size {
+					_ = pq.Pop()
+				}
+			}
+		})
+	}
+}
+
+func TestPriorityQueuePushAll(t *testing.T) {
+	pq := NewMinIntPQ()
+	pq.PushAll()
+	assert.True(t, pq.Empty())
+
+	// Large batch into an empty queue takes the rebuild path
+	values := rand.New(rand.NewSource(11)).Perm(1000)
+	pq.PushAll(values...)
+	assert.Equal(t, 1000, pq.Size())
+
+	// Small batch into a large queue takes the sift-up path
+	pq.PushAll(-3, -1, -2)
+	assert.Equal(t, []int{-3, -2, -1, 0, 1}, pq.PopN(5))
+	for want := 2; want < 1000; want++ {
+		require.Equal(t, want, pq.Pop())
+	}
+}
+
+func TestPriorityQueuePushAllStable(t *testing.T) {
+	type job struct{ priority, id int }
+	pq := NewStablePriorityQueue(func(a, b job) bool { return a.priority < b.priority }, job{0, 0})
+	batch := make([]job, 50)
+	for i := range batch {
+		batch[i] = job{priority: i % 2, id: i + 1}
+	}
+	pq.PushAll(batch...)
+
+	prev := map[int]int{0: -1, 1: -1}
+	for _, j := range pq.PopN(pq.Size()) {
+		assert.Greater(t, j.id, prev[j.priority])
+		prev[j.priority] = j.id
+	}
+}
+
+func TestPriorityQueuePushAllKeepsHandles(t *testing.T) {
+	pq := NewMinIntPQ()
+	h := pq.PushHandle(50)
+	pq.PushAll(rand.New(rand.NewSource(2)).Perm(100)...)
+
+	require.NoError(t, pq.Update(h, -1))
+	assert.Equal(t, -1, pq.Pop())
+}
+
+func TestPriorityQueuePopN(t *testing.T) {
+	pq := NewMaxIntPQ(5, 3, 9, 1)
+	assert.Equal(t, []int{}, pq.PopN(0))
+	assert.Equal(t, []int{}, pq.PopN(-2))
+	assert.Equal(t, []int{9, 5}, pq.PopN(2))
+	assert.Equal(t, []int{3, 1}, pq.PopN(10))
+	assert.Equal(t, []int{}, pq.PopN(1))
+}
+
+func BenchmarkPushAll(b *testing.B) {
+	values := rand.New(rand.NewSource(1)).Perm(4096)
+
+	b.Run("Push", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pq := NewMinIntPQ()
+			for _, v := range values {
+				pq.Push(v)
+			}
+		}
+	})
+	b.Run("PushAll", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pq := NewMinIntPQ()
+			pq.PushAll(values...)
+		}
+	})
+}
+
+func TestPriorityQueueGrow(t *testing.T) {
+	pq := NewMinIntPQ()
+	pq.Grow(0)
+	assert.Equal(t, 0, pq.Cap())
+
+	pq.Grow(500)
+	assert.GreaterOrEqual(t, pq.Cap(), 500)
+	capacity := pq.Cap()
+	for i := range 500 {
+		pq.Push(i)
+	}
+	assert.Equal(t, capacity, pq.Cap(), "pushing within the reserved space must not reallocate")
+
+	// Automatic shrinking never drops below the reservation
+	pq.PopN(499)
+	assert.Equal(t, capacity, pq.Cap())
+	assert.Equal(t, 499, pq.Pop())
+}
+
+func TestPriorityQueueClearKeepsReservation(t *testing.T) {
+	pq := NewMinIntPQ()
+	pq.Grow(1000)
+	capacity := pq.Cap()
+	h := pq.PushHandle(7)
+	pq.PushAll(3, 5)
+
+	pq.Clear()
+	assert.Equal(t, 0, pq.Size())
+	assert.Equal(t, capacity, pq.Cap(), "Clear must keep the capacity reserved by Grow")
+	assert.Equal(t, ErrInvalidHandle, pq.Update(h, 1))
+	for i := range 1000 {
+		pq.Push(i)
+	}
+	assert.Equal(t, capacity, pq.Cap())
+
+	// Capacity grown past the reservation is released
+	for i := range 2000 {
+		pq.Push(i)
+	}
+	pq.Clear()
+	assert.Equal(t, capacity, pq.Cap())
+
+	// Merging empties the source but keeps its reservation
+	other := NewMinIntPQ()
+	other.Grow(100)
+	otherCap := other.Cap()
+	other.PushAll(1, 2)
+	pq.Merge(other)
+	assert.Equal(t, otherCap, other.Cap())
+	assert.Equal(t, 0, other.Size())
+
+	// Without a reservation Clear releases everything
+	plain := NewMinIntPQ(1, 2, 3)
+	plain.Clear()
+	assert.Equal(t, 0, plain.Cap())
+}
+
+func TestPriorityQueueAutoShrink(t *testing.T) {
+	pq := NewMinIntPQ()
+	for i := range 10000 {
+		pq.Push(i)
+	}
+	peak := pq.Cap()
+
+	for want := range 9990 {
+		require.Equal(t, want, pq.Pop())
+	}
+	assert.Less(t, pq.Cap(), peak/8)
+	assert.GreaterOrEqual(t, pq.Cap(), pq.Size())
+	assert.Equal(t, []int{9990, 9991, 9992}, pq.PopN(3))
+}
+
+func TestPriorityQueueShrink(t *testing.T) {
+	pq := NewMinIntPQ()
+	pq.Grow(1000)
+	h := pq.PushHandle(3)
+	pq.PushAll(2, 1)
+
+	pq.Shrink()
+	assert.Equal(t, 3, pq.Cap())
+	require.NoError(t, pq.Update(h, 0))
+	assert.Equal(t, []int{0, 1, 2}, pq.PopN(3))
+
+	pq.Shrink()
+	assert.Equal(t, 0, pq.Cap())
+	pq.Push(5)
+	assert.Equal(t, 5, pq.Pop())
+}
+
+func TestPriorityQueueDeepHeap(t *testing.T) {
+	// A long, already-sorted descending input exercises the deepest sift-down paths
+	const n = 1 << 16
+	values := make([]int, n)
+	for i := range values {
+		values[i] = n - i
+	}
+	pq := NewMinIntPQ(values...)
+	for want := 1; want <= n; want++ {
+		if got := pq.Pop(); got != want {
+			t.Fatalf("expected %d, got %d", want, got)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package pq
+
+import (
+	"cmp"
+	"slices"
+)
+
+// TopK is a thread-safe bounded heap that retains the K highest-priority
+// elements pushed into it. Internally it is a heap ordered worst-first, so
+// the element to evict is always at the root.
+type TopK[T any] struct {
+	heap   *PriorityQueue[T]
+	better Comparable[T]
+	k      int
+}
+
+// NewTopK initializes a TopK keeping at most k elements, where compFunc(a, b)
+// reports whether a ranks above b. It panics if k is less than 1.
+func NewTopK[T any](k int, compFunc Comparable[T], elements ...T) *TopK[T] {
+	if k < 1 {
+		panic("pq: top-k capacity must be positive")
+	}
+	tk := &TopK[T]{
+		heap: NewPriorityQueue(func(a, b T) bool {
+			return compFunc(b, a)
+		}),
+		better: compFunc,
+		k:      k,
+	}
+	for _, e := range elements {
+		tk.Push(e)
+	}
+	return tk
+}
+
+// NewTopKLargest keeps the k largest elements of an ordered type.
+func NewTopKLargest[T cmp.Ordered](k int, elements ...T) *TopK[T] {
+	return NewTopK(k, func(a, b T) bool {
+		return cmp.Less(b, a)
+	}, elements...)
+}
+
+// NewTopKSmallest keeps the k smallest elements of an ordered type.
+func NewTopKSmallest[T cmp.Ordered](k int, elements ...T) *TopK[T] {
+	return NewTopK(k, cmp.Less[T], elements...)
+}
+
+// Push offers data to the heap. When the heap is already full, the worst of
+// the retained elements and data is evicted and returned with true.
+func (tk *TopK[T]) Push(data T) (T, bool) {
+	tk.heap.mu.Lock()
+	defer tk.heap.mu.Unlock()
+
+	var zero T
+	table := tk.heap.table
+	if len(table) < tk.k {
+		tk.heap.push(entry[T]{value: data})
+		return zero, false
+	}
+	if !tk.better(data, table[0].value) {
+		return data, true
+	}
+	evicted := table[0].value
+	table[0].value = data
+	tk.heap.heapify(0)
+	return evicted, true
+}
+
+// Worst returns the lowest-ranked retained element, i.e. the threshold a new
+// element must beat once the heap is full.
+func (tk *TopK[T]) Worst() (T, bool) {
+	return tk.heap.Peek()
+}
+
+// Sorted returns a copy of the retained elements, best first.
+// The heap itself is left unchanged.
+func (tk *TopK[T]) Sorted() []T {
+	tk.heap.mu.RLock()
+	values := make([]T, len(tk.heap.table))
+	for i, e := range tk.heap.table {
+		values[i] = e.value
+	}
+	tk.heap.mu.RUnlock()
+
+	slices.SortFunc(values, func(a, b T) int {
+		switch {
+		case tk.better(a, b):
+			return -1
+		case tk.better(b, a):
+			return 1
+		}
+		return 0
+	})
+	return values
+}
+
+// K returns the maximum number of elements retained.
+func (tk *TopK[T]) K() int {
+	return tk.k
+}
+
+// Size returns the number of elements currently retained.
+func (tk *TopK[T]) Size() int {
+	return tk.heap.Size()
+}
+
+// Empty returns true if no element is retained.
+func (tk *TopK[T]) Empty() bool {
+	return tk.heap.Empty()
+}
+
+// Clear removes all retained elements.
+func (tk *TopK[T]) Clear() {
+	tk.heap.Clear()
+}
@@ -0,0 +1,106 @@
+package pq
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTopK(t *testing.T) {
+	tk := NewTopKLargest(3, 5, 1, 9, 7, 3)
+	assert.Equal(t, 3, tk.K())
+	assert.Equal(t, 3, tk.Size())
+	assert.Equal(t, []int{9, 7, 5}, tk.Sorted())
+
+	worst, ok := tk.Worst()
+	assert.True(t, ok)
+	assert.Equal(t, 5, worst)
+
+	assert.Panics(t, func() { NewTopKSmallest[int](0) })
+}
+
+func TestTopKPushEviction(t *testing.T) {
+	tk := NewTopKLargest[int](2)
+
+	_, evicted := tk.Push(1)
+	assert.False(t, evicted)
+	_, evicted = tk.Push(2)
+	assert.False(t, evicted)
+
+	// A better element evicts the current worst
+	v, evicted := tk.Push(3)
+	assert.True(t, evicted)
+	assert.Equal(t, 1, v)
+
+	// A worse element is evicted itself
+	v, evicted = tk.Push(0)
+	assert.True(t, evicted)
+	assert.Equal(t, 0, v)
+
+	assert.Equal(t, []int{3, 2}, tk.Sorted())
+}
+
+func TestTopKSmallest(t *testing.T) {
+	tk := NewTopKSmallest(3, "pear", "apple", "fig", "kiwi", "banana")
+	assert.Equal(t, []string{"apple", "banana", "fig"}, tk.Sorted())
+}
+
+func TestTopKSortedIsNonDestructive(t *testing.T) {
+	tk := NewTopKLargest[int](5)
+	for _, v := range rand.New(rand.NewSource(1)).Perm(1000) {
+		tk.Push(v)
+	}
+	assert.Equal(t, []int{999, 998, 997, 996, 995}, tk.Sorted())
+	assert.Equal(t, tk.Sorted(), tk.Sorted())
+	assert.Equal(t, 5, tk.Size())
+
+	tk.Clear()
+	assert.True(t, tk.Empty())
+	assert.Empty(t, tk.Sorted())
+	_, ok := tk.Worst()
+	assert.False(t, ok)
+}
+
+func TestTopKCustomType(t *testing.T) {
+	type hit struct {
+		path  string
+		count int
+	}
+	tk := NewTopK(2, func(a, b hit) bool { return a.count > b.count })
+	tk.Push(hit{"/a", 10})
+	tk.Push(hit{"/b", 30})
+	tk.Push(hit{"/c", 20})
+
+	top := tk.Sorted()
+	assert.Equal(t, "/b", top[0].path)
+	assert.Equal(t, "/c", top[1].path)
+}
+
+func TestTopKConcurrentAccess(t *testing.T) {
+	tk := NewTopKLargest[int](10)
+	var wg sync.WaitGroup
+	numGoroutines := 10
+	opsPerGoroutine := 500
+
+	for i := range numGoroutines {
+		wg.Add(1)
+		go func(base int) {
+			defer wg.Done()
+			for j := range opsPerGoroutine {
+				tk.Push(base*opsPerGoroutine + j)
+				_ = tk.Sorted()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	want := make([]int, 10)
+	for i := range want {
+		want[i] = numGoroutines*opsPerGoroutine - 1 - i
+	}
+	assert.Equal(t, want, tk.Sorted())
+	assert.True(t, slices.IsSortedFunc(tk.Sorted(), func(a, b int) int { return b - a }))
+}
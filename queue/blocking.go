@@ -0,0 +1,114 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ckshitij/collection/internal/signal"
+)
+
+// ErrQueueClosed is returned once a BlockingQueue has been closed and,
+// for consumers, fully drained.
+var ErrQueueClosed = errors.New("invalid operation: queue is closed")
+
+// BlockingQueue is a FIFO queue whose consumers can wait for elements.
+// Closing the queue wakes every waiter; elements enqueued before Close
+// can still be dequeued until the queue is drained.
+type BlockingQueue[T any] struct {
+	queue    *Queue[T]
+	mu       sync.Mutex
+	notEmpty signal.Signal
+	closed   bool
+}
+
+// NewBlockingQueue creates and returns a new, open BlockingQueue.
+func NewBlockingQueue[T any](elements ...T) *BlockingQueue[T] {
+	bq := &BlockingQueue[T]{
+		queue: NewQueue[T](),
+	}
+	for _, e := range elements {
+		bq.queue.Enqueue(e)
+	}
+	return bq
+}
+
+// Enqueue adds value to the back of the queue and wakes waiting consumers.
+// Returns ErrQueueClosed if the queue has been closed.
+func (bq *BlockingQueue[T]) Enqueue(value T) error {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	if bq.closed {
+		return ErrQueueClosed
+	}
+	bq.queue.Enqueue(value)
+	bq.notEmpty.Broadcast()
+	return nil
+}
+
+// TryDequeue removes and returns the front element without waiting.
+// The boolean is false if the queue is empty.
+func (bq *BlockingQueue[T]) TryDequeue() (T, bool) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.queue.TryDequeue()
+}
+
+// DequeueWait removes and returns the front element, blocking until one is
+// available, the queue is closed and drained (ErrQueueClosed), or ctx is
+// done (ctx.Err()).
+func (bq *BlockingQueue[T]) DequeueWait(ctx context.Context) (T, error) {
+	var zero T
+	for {
+		bq.mu.Lock()
+		if value, ok := bq.queue.TryDequeue(); ok {
+			bq.mu.Unlock()
+			return value, nil
+		}
+		if bq.closed {
+			bq.mu.Unlock()
+			return zero, ErrQueueClosed
+		}
+		ready := bq.notEmpty.Wait()
+		bq.mu.Unlock()
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// Close marks the queue as closed and wakes all waiting consumers.
+// Closing an already closed queue is a no-op.
+func (bq *BlockingQueue[T]) Close() {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	if bq.closed {
+		return
+	}
+	bq.closed = true
+	bq.notEmpty.Broadcast()
+}
+
+// IsClosed returns true if Close has been called.
+func (bq *BlockingQueue[T]) IsClosed() bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.closed
+}
+
+// IsEmpty returns true if the queue holds no elements.
+func (bq *BlockingQueue[T]) IsEmpty() bool {
+	return bq.queue.IsEmpty()
+}
+
+// Size returns the number of elements in the queue.
+func (bq *BlockingQueue[T]) Size() int {
+	return bq.queue.Size()
+}
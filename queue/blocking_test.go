@@ -0,0 +1,154 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlockingQueue(t *testing.T) {
+	bq := NewBlockingQueue(1, 2, 3)
+	assert.Equal(t, 3, bq.Size())
+	assert.False(t, bq.IsEmpty())
+	assert.False(t, bq.IsClosed())
+
+	v, ok := bq.TryDequeue()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+}
+
+func TestBlockingQueueDequeueWaitReady(t *testing.T) {
+	bq := NewBlockingQueue[int]()
+	require.NoError(t, bq.Enqueue(10))
+
+	v, err := bq.DequeueWait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 10, v)
+	assert.True(t, bq.IsEmpty())
+}
+
+func TestBlockingQueueDequeueWaitBlocks(t *testing.T) {
+	bq := NewBlockingQueue[int]()
+	result := make(chan int)
+
+	go func() {
+		v, err := bq.DequeueWait(context.Background())
+		assert.NoError(t, err)
+		result <- v
+	}()
+
+	select {
+	case <-result:
+		t.Fatal("DequeueWait returned before an element was enqueued")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	require.NoError(t, bq.Enqueue(42))
+	assert.Equal(t, 42, <-result)
+}
+
+func TestBlockingQueueDequeueWaitContext(t *testing.T) {
+	bq := NewBlockingQueue[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := bq.DequeueWait(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The queue stays usable after a cancelled wait
+	require.NoError(t, bq.Enqueue(1))
+	v, err := bq.DequeueWait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+}
+
+func TestBlockingQueueCloseWakesWaiters(t *testing.T) {
+	bq := NewBlockingQueue[int]()
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := bq.DequeueWait(context.Background())
+			errs <- err
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	bq.Close()
+	bq.Close() // idempotent
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.ErrorIs(t, err, ErrQueueClosed)
+	}
+	assert.True(t, bq.IsClosed())
+}
+
+func TestBlockingQueueDrainAfterClose(t *testing.T) {
+	bq := NewBlockingQueue(1, 2)
+	bq.Close()
+
+	assert.ErrorIs(t, bq.Enqueue(3), ErrQueueClosed)
+
+	v, err := bq.DequeueWait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	v, err = bq.DequeueWait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+
+	_, err = bq.DequeueWait(context.Background())
+	assert.ErrorIs(t, err, ErrQueueClosed)
+}
+
+func TestBlockingQueueConcurrentProducersConsumers(t *testing.T) {
+	bq := NewBlockingQueue[int]()
+	numProducers := 8
+	perProducer := 500
+
+	var producers sync.WaitGroup
+	for i := range numProducers {
+		producers.Add(1)
+		go func(base int) {
+			defer producers.Done()
+			for j := range perProducer {
+				assert.NoError(t, bq.Enqueue(base*perProducer+j))
+			}
+		}(i)
+	}
+
+	var consumers sync.WaitGroup
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	for range 4 {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				v, err := bq.DequeueWait(context.Background())
+				if err != nil {
+					assert.ErrorIs(t, err, ErrQueueClosed)
+					return
+				}
+				mu.Lock()
+				seen[v] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	producers.Wait()
+	bq.Close()
+	consumers.Wait()
+
+	assert.Len(t, seen, numProducers*perProducer)
+	assert.True(t, bq.IsEmpty())
+}
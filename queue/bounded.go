@@ -0,0 +1,156 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ckshitij/collection/internal/signal"
+)
+
+// ErrFull is returned when an element cannot be added to a full BoundedQueue.
+var ErrFull = errors.New("invalid operation: queue is full")
+
+// OverflowPolicy decides what a BoundedQueue does when Enqueue is called at capacity.
+type OverflowPolicy int
+
+const (
+	// Block waits until a consumer frees space.
+	Block OverflowPolicy = iota
+	// Reject refuses the new element with ErrFull.
+	Reject
+	// DropOldest evicts the front element to make room for the new one.
+	DropOldest
+	// DropNewest discards the new element and keeps the queue unchanged.
+	DropNewest
+)
+
+// BoundedQueue is a FIFO queue holding at most Cap() elements.
+type BoundedQueue[T any] struct {
+	queue    *Queue[T]
+	capacity int
+	policy   OverflowPolicy
+	mu       sync.Mutex
+	notFull  signal.Signal
+}
+
+// NewBoundedQueue creates a BoundedQueue with the given capacity and overflow policy.
+// It panics if capacity is less than 1.
+func NewBoundedQueue[T any](capacity int, policy OverflowPolicy) *BoundedQueue[T] {
+	if capacity < 1 {
+		panic("queue: bounded queue capacity must be positive")
+	}
+	return &BoundedQueue[T]{
+		queue:    NewQueue[T](),
+		capacity: capacity,
+		policy:   policy,
+	}
+}
+
+// Enqueue adds value to the back of the queue, applying the overflow policy
+// when the queue is full. With the Block policy it waits indefinitely.
+func (bq *BoundedQueue[T]) Enqueue(value T) error {
+	return bq.EnqueueWait(context.Background(), value)
+}
+
+// EnqueueWait behaves like Enqueue but, under the Block policy, gives up and
+// returns ctx.Err() once ctx is done. Other policies never wait.
+func (bq *BoundedQueue[T]) EnqueueWait(ctx context.Context, value T) error {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	for bq.queue.Size() >= bq.capacity {
+		switch bq.policy {
+		case Reject:
+			return ErrFull
+		case DropNewest:
+			return nil
+		case DropOldest:
+			bq.queue.TryDequeue()
+		default:
+			ready := bq.notFull.Wait()
+			bq.mu.Unlock()
+			select {
+			case <-ready:
+				bq.mu.Lock()
+			case <-ctx.Done():
+				bq.mu.Lock()
+				return ctx.Err()
+			}
+		}
+	}
+	bq.queue.Enqueue(value)
+	return nil
+}
+
+// Dequeue removes the front element from the queue.
+// Returns an error if the queue is empty.
+func (bq *BoundedQueue[T]) Dequeue() error {
+	if _, ok := bq.TryDequeue(); !ok {
+		return errors.New("invalid operation: empty queue")
+	}
+	return nil
+}
+
+// TryDequeue removes and returns the front element in a single atomic step.
+// The boolean is false if the queue is empty.
+func (bq *BoundedQueue[T]) TryDequeue() (T, bool) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	value, ok := bq.queue.TryDequeue()
+	if ok {
+		bq.notFull.Broadcast()
+	}
+	return value, ok
+}
+
+// Peek returns the front element without removing it.
+// The boolean is false if the queue is empty.
+func (bq *BoundedQueue[T]) Peek() (T, bool) {
+	return bq.queue.Peek()
+}
+
+// Front returns the front element of the queue without removing it.
+// Returns the zero value of the type if the queue is empty.
+func (bq *BoundedQueue[T]) Front() T {
+	return bq.queue.Front()
+}
+
+// Back returns the back element of the queue without removing it.
+// Returns the zero value of the type if the queue is empty.
+func (bq *BoundedQueue[T]) Back() T {
+	return bq.queue.Back()
+}
+
+// IsEmpty returns true if the queue is empty, otherwise false.
+func (bq *BoundedQueue[T]) IsEmpty() bool {
+	return bq.queue.IsEmpty()
+}
+
+// Size returns the number of elements in the queue.
+func (bq *BoundedQueue[T]) Size() int {
+	return bq.queue.Size()
+}
+
+// Cap returns the maximum number of elements the queue can hold.
+func (bq *BoundedQueue[T]) Cap() int {
+	return bq.capacity
+}
+
+// Remaining returns how many more elements fit before the queue is full.
+func (bq *BoundedQueue[T]) Remaining() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.capacity - bq.queue.Size()
+}
+
+// Clear removes all elements from the queue and wakes blocked producers.
+func (bq *BoundedQueue[T]) Clear() {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	bq.queue.Clear()
+	bq.notFull.Broadcast()
+}
@@ -0,0 +1,142 @@
+package queue
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBoundedQueue(t *testing.T) {
+	bq := NewBoundedQueue[int](3, Reject)
+	assert.Equal(t, 3, bq.Cap())
+	assert.Equal(t, 3, bq.Remaining())
+	assert.True(t, bq.IsEmpty())
+
+	assert.Panics(t, func() { NewBoundedQueue[int](0, Block) })
+}
+
+func TestBoundedQueueReject(t *testing.T) {
+	bq := NewBoundedQueue[int](2, Reject)
+	require.NoError(t, bq.Enqueue(1))
+	require.NoError(t, bq.Enqueue(2))
+	assert.Equal(t, 0, bq.Remaining())
+
+	assert.ErrorIs(t, bq.Enqueue(3), ErrFull)
+	assert.Equal(t, 1, bq.Front())
+	assert.Equal(t, 2, bq.Back())
+
+	require.NoError(t, bq.Dequeue())
+	assert.Equal(t, 1, bq.Remaining())
+	require.NoError(t, bq.Enqueue(3))
+	assert.Equal(t, 3, bq.Back())
+}
+
+func TestBoundedQueueDropOldest(t *testing.T) {
+	bq := NewBoundedQueue[int](2, DropOldest)
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, bq.Enqueue(i))
+	}
+	assert.Equal(t, 2, bq.Size())
+	assert.Equal(t, 3, bq.Front())
+	assert.Equal(t, 4, bq.Back())
+}
+
+func TestBoundedQueueDropNewest(t *testing.T) {
+	bq := NewBoundedQueue[int](2, DropNewest)
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, bq.Enqueue(i))
+	}
+	assert.Equal(t, 2, bq.Size())
+	assert.Equal(t, 1, bq.Front())
+	assert.Equal(t, 2, bq.Back())
+}
+
+func TestBoundedQueueBlock(t *testing.T) {
+	bq := NewBoundedQueue[int](1, Block)
+	require.NoError(t, bq.Enqueue(1))
+
+	done := make(chan error)
+	go func() {
+		done <- bq.Enqueue(2)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Enqueue returned while the queue was full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	v, ok := bq.TryDequeue()
+	require.True(t, ok)
+	assert.Equal(t, 1, v)
+	require.NoError(t, <-done)
+	assert.Equal(t, 2, bq.Front())
+}
+
+func TestBoundedQueueBlockContext(t *testing.T) {
+	bq := NewBoundedQueue[int](1, Block)
+	require.NoError(t, bq.Enqueue(1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, bq.EnqueueWait(ctx, 2), context.DeadlineExceeded)
+	assert.Equal(t, 1, bq.Size())
+
+	// Clear frees space for blocked producers
+	done := make(chan error)
+	go func() {
+		done <- bq.EnqueueWait(context.Background(), 3)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	bq.Clear()
+	require.NoError(t, <-done)
+	assert.Equal(t, 3, bq.Front())
+}
+
+func TestBoundedQueueEmpty(t *testing.T) {
+	bq := NewBoundedQueue[string](1, Block)
+	assert.EqualError(t, bq.Dequeue(), "invalid operation: empty queue")
+
+	v, ok := bq.Peek()
+	assert.False(t, ok)
+	assert.Equal(t, "", v)
+	assert.Equal(t, "", bq.Front())
+	assert.Equal(t, "", bq.Back())
+}
+
+func TestBoundedQueueConcurrentAccess(t *testing.T) {
+	bq := NewBoundedQueue[int](16, Block)
+	numProducers := 8
+	perProducer := 200
+	total := numProducers * perProducer
+
+	var wg sync.WaitGroup
+	for i := range numProducers {
+		wg.Add(1)
+		go func(base int) {
+			defer wg.Done()
+			for j := range perProducer {
+				assert.NoError(t, bq.Enqueue(base*perProducer+j))
+			}
+		}(i)
+	}
+
+	received := 0
+	for received < total {
+		if _, ok := bq.TryDequeue(); ok {
+			received++
+			assert.LessOrEqual(t, bq.Size(), bq.Cap())
+		} else {
+			runtime.Gosched()
+		}
+	}
+	wg.Wait()
+
+	assert.True(t, bq.IsEmpty())
+	assert.Equal(t, bq.Cap(), bq.Remaining())
+}
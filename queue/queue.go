@@ -8,62 +8,130 @@ import (
 
 // Queue represents a generic queue data structure that holds elements of any type.
 type Queue[T any] struct {
-	head *list.List[T]
+	store storage[T]
 }
 
-// NewQueue creates and returns a new instance of Queue.
+// storage is the FIFO backend behind a Queue. Implementations must be safe
+// for concurrent use and perform each method as a single atomic step.
+type storage[T any] interface {
+	pushBack(value T)
+	popFront() (T, bool)
+	front() (T, bool)
+	back() (T, bool)
+	len() int
+	clear()
+}
+
+// NewQueue creates and returns a new instance of Queue backed by a
+// concurrency-safe doubly linked list.
 func NewQueue[T any]() *Queue[T] {
 	return &Queue[T]{
-		head: list.NewList[T](),
+		store: &listStorage[T]{list: list.NewList[T]()},
+	}
+}
+
+// NewRingQueue creates and returns a new instance of Queue backed by a
+// growable circular buffer with room for capacity elements before the
+// first reallocation. It avoids a per-element node allocation and suits
+// high-throughput queues of small values.
+func NewRingQueue[T any](capacity int, elements ...T) *Queue[T] {
+	q := &Queue[T]{
+		store: newRing[T](max(capacity, len(elements))),
+	}
+	for _, e := range elements {
+		q.Enqueue(e)
 	}
+	return q
 }
 
 // Enqueue adds a new element to the back of the queue.
 func (q *Queue[T]) Enqueue(value T) {
-	q.head.PushBack(value)
+	q.store.pushBack(value)
 }
 
 // IsEmpty returns true if the queue is empty, otherwise false.
 func (q *Queue[T]) IsEmpty() bool {
-	return q.head.Len() == 0
+	return q.store.len() == 0
 }
 
 // Dequeue removes the front element from the queue.
 // Returns an error if the queue is empty.
 func (q *Queue[T]) Dequeue() error {
-	if q.IsEmpty() {
+	if _, ok := q.store.popFront(); !ok {
 		return errors.New("invalid operation: empty queue")
 	}
-	q.head.PopFront()
 	return nil
 }
 
+// TryDequeue removes and returns the front element in a single atomic step.
+// The boolean is false if the queue is empty.
+func (q *Queue[T]) TryDequeue() (T, bool) {
+	return q.store.popFront()
+}
+
+// Peek returns the front element without removing it.
+// The boolean is false if the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.store.front()
+}
+
 // Front returns the front element of the queue without removing it.
 // Returns the zero value of the type if the queue is empty.
 func (q *Queue[T]) Front() T {
-	var zero T
-	if node := q.head.Front(); node != nil {
-		return node.Element()
-	}
-	return zero
+	value, _ := q.store.front()
+	return value
 }
 
 // Back returns the back element of the queue without removing it.
 // Returns the zero value of the type if the queue is empty.
 func (q *Queue[T]) Back() T {
-	var zero T
-	if node := q.head.Back(); node != nil {
-		return node.Element()
-	}
-	return zero
+	value, _ := q.store.back()
+	return value
 }
 
 // Size returns the number of elements in the queue.
 func (q *Queue[T]) Size() int {
-	return q.head.Len()
+	return q.store.len()
 }
 
 // Clear removes all elements from the queue.
 func (q *Queue[T]) Clear() {
-	q.head.Clear()
+	q.store.clear()
+}
+
+// listStorage adapts list.List to the storage interface.
+type listStorage[T any] struct {
+	list *list.List[T]
+}
+
+func (s *listStorage[T]) pushBack(value T) {
+	s.list.PushBack(value)
+}
+
+func (s *listStorage[T]) popFront() (T, bool) {
+	return s.list.PopFront()
+}
+
+func (s *listStorage[T]) front() (T, bool) {
+	var zero T
+	if node := s.list.Front(); node != nil {
+		return node.Element(), true
+	}
+	return zero, false
+}
+
+func (s *listStorage[T]) back() (T, bool) {
+	var zero T
+	if node := s.list.Back(); node != nil {
+		return node.Element(), true
+	}
+	return zero, false
+}
+
+func (s *listStorage[T]) len() int {
+	return s.list.Len()
+}
+
+func (s *listStorage[T]) clear() {
+	s.list.Clear()
 }
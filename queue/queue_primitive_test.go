@@ -17,6 +17,24 @@ func TestNewIntQueue(t *testing.T) {
 	assert.Equal(t, 3, val)
 }
 
+func TestPrimitiveQueuesAreRingBacked(t *testing.T) {
+	_, ok := NewIntQueue().store.(*ring[int])
+	assert.True(t, ok)
+	_, ok = NewByteQueue('a').store.(*ring[byte])
+	assert.True(t, ok)
+
+	// Growing past the initial elements keeps FIFO order
+	q := NewByteQueue('a', 'b')
+	for _, b := range []byte("cdefghijk") {
+		q.Enqueue(b)
+	}
+	var got []byte
+	for v, ok := q.TryDequeue(); ok; v, ok = q.TryDequeue() {
+		got = append(got, v)
+	}
+	assert.Equal(t, []byte("abcdefghijk"), got)
+}
+
 func TestNewInt8Queue(t *testing.T) {
 	q := NewInt8Queue(1, 2, 3)
 	assert.Equal(t, 3, q.Size())
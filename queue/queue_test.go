@@ -75,6 +75,38 @@ func TestQueueBack(t *testing.T) {
 	assert.Equal(t, 60, q.Back()) // Back should not change after dequeue
 }
 
+func TestQueueTryDequeue(t *testing.T) {
+	q := NewQueue[int]()
+	v, ok := q.TryDequeue()
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+
+	q.Enqueue(0)
+	q.Enqueue(5)
+	v, ok = q.TryDequeue()
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+
+	v, ok = q.TryDequeue()
+	assert.True(t, ok)
+	assert.Equal(t, 5, v)
+	assert.True(t, q.IsEmpty())
+}
+
+func TestQueuePeek(t *testing.T) {
+	q := NewQueue[int]()
+	v, ok := q.Peek()
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+
+	q.Enqueue(0)
+	q.Enqueue(1)
+	v, ok = q.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+	assert.Equal(t, 2, q.Size())
+}
+
 func TestQueue_Clear(t *testing.T) {
 	q := NewIntQueue(1, 2, 3)
 	assert.Equal(t, 3, q.Size())
@@ -113,6 +145,17 @@ func TestQueueConcurrentAccess(t *testing.T) {
 		}()
 	}
 
+	// Concurrent TryDequeue
+	for i := 0; i < numGoroutines/2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < opsPerGoroutine/2; j++ {
+				_, _ = q.TryDequeue()
+			}
+		}()
+	}
+
 	// Concurrent Size/Front/Back calls
 	for range numGoroutines {
 		wg.Add(1)
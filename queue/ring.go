@@ -0,0 +1,92 @@
+package queue
+
+import "sync"
+
+// minRingCapacity is the smallest buffer allocated once a ring grows.
+const minRingCapacity = 8
+
+// ring is a concurrency-safe growable circular buffer implementing storage.
+type ring[T any] struct {
+	buf  []T
+	head int
+	size int
+	mu   sync.RWMutex
+}
+
+func newRing[T any](capacity int) *ring[T] {
+	return &ring[T]{buf: make([]T, max(capacity, 0))}
+}
+
+func (r *ring[T]) pushBack(value T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.size == len(r.buf) {
+		r.grow()
+	}
+	r.buf[(r.head+r.size)%len(r.buf)] = value
+	r.size++
+}
+
+func (r *ring[T]) popFront() (T, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	value := r.buf[r.head]
+	r.buf[r.head] = zero // release the reference for the GC
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+	return value, true
+}
+
+func (r *ring[T]) front() (T, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	return r.buf[r.head], true
+}
+
+func (r *ring[T]) back() (T, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	return r.buf[(r.head+r.size-1)%len(r.buf)], true
+}
+
+func (r *ring[T]) len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.size
+}
+
+func (r *ring[T]) clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clear(r.buf)
+	r.head = 0
+	r.size = 0
+}
+
+// grow doubles the buffer and unwraps the elements to start at index 0.
+// Must be called with r.mu held and the buffer full.
+func (r *ring[T]) grow() {
+	newBuf := make([]T, max(2*len(r.buf), minRingCapacity))
+	n := copy(newBuf, r.buf[r.head:])
+	copy(newBuf[n:], r.buf[:r.head])
+	r.buf = newBuf
+	r.head = 0
+}
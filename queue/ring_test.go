@@ -0,0 +1,146 @@
+package queue
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRingQueue(t *testing.T) {
+	q := NewRingQueue[int](4)
+	assert.True(t, q.IsEmpty())
+	assert.Equal(t, 0, q.Front())
+	assert.Equal(t, 0, q.Back())
+
+	q = NewRingQueue(0, 1, 2, 3)
+	assert.Equal(t, 3, q.Size())
+	assert.Equal(t, 1, q.Front())
+	assert.Equal(t, 3, q.Back())
+}
+
+func TestRingQueueFIFO(t *testing.T) {
+	q := NewRingQueue[int](2)
+	for i := range 20 {
+		q.Enqueue(i)
+	}
+	assert.Equal(t, 20, q.Size())
+	for i := range 20 {
+		v, ok := q.TryDequeue()
+		require.True(t, ok)
+		assert.Equal(t, i, v)
+	}
+	_, ok := q.TryDequeue()
+	assert.False(t, ok)
+	assert.EqualError(t, q.Dequeue(), "invalid operation: empty queue")
+}
+
+func TestRingQueueWrapAround(t *testing.T) {
+	q := NewRingQueue[int](4)
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	require.NoError(t, q.Dequeue())
+	require.NoError(t, q.Dequeue())
+
+	// Tail wraps past the end of the buffer, then a grow must unwrap it
+	q.Enqueue(4)
+	q.Enqueue(5)
+	q.Enqueue(6)
+	assert.Equal(t, 3, q.Front())
+	assert.Equal(t, 6, q.Back())
+	q.Enqueue(7)
+	q.Enqueue(8)
+
+	var got []int
+	for !q.IsEmpty() {
+		v, _ := q.TryDequeue()
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{3, 4, 5, 6, 7, 8}, got)
+}
+
+func TestRingQueuePeekAndClear(t *testing.T) {
+	q := NewRingQueue(0, "a", "b")
+	v, ok := q.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "a", v)
+
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+	_, ok = q.Peek()
+	assert.False(t, ok)
+
+	q.Enqueue("c")
+	assert.Equal(t, "c", q.Front())
+	assert.Equal(t, "c", q.Back())
+}
+
+func TestRingQueueConcurrentAccess(t *testing.T) {
+	q := NewRingQueue[int](0)
+	var wg sync.WaitGroup
+	numGoroutines := 20
+	opsPerGoroutine := 1000
+
+	for i := range numGoroutines {
+		wg.Add(1)
+		go func(base int) {
+			defer wg.Done()
+			for j := range opsPerGoroutine {
+				q.Enqueue(base*opsPerGoroutine + j)
+			}
+		}(i)
+	}
+	var mu sync.Mutex
+	dequeued := 0
+	for range numGoroutines / 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range opsPerGoroutine / 2 {
+				if _, ok := q.TryDequeue(); ok {
+					mu.Lock()
+					dequeued++
+					mu.Unlock()
+				}
+				_ = q.Front()
+				_ = q.Back()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, numGoroutines*opsPerGoroutine-dequeued, q.Size())
+}
+
+func benchmarkQueue(b *testing.B, newQueue func() *Queue[int]) {
+	b.Run("EnqueueDequeue", func(b *testing.B) {
+		q := newQueue()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			q.Enqueue(i)
+			_, _ = q.TryDequeue()
+		}
+	})
+	b.Run("Burst1024", func(b *testing.B) {
+		q := newQueue()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := range 1024 {
+				q.Enqueue(j)
+			}
+			for range 1024 {
+				_, _ = q.TryDequeue()
+			}
+		}
+	})
+}
+
+func BenchmarkListQueue(b *testing.B) {
+	benchmarkQueue(b, NewQueue[int])
+}
+
+func BenchmarkRingQueue(b *testing.B) {
+	benchmarkQueue(b, func() *Queue[int] { return NewRingQueue[int](0) })
+}
@@ -0,0 +1,74 @@
+package stack
+
+import (
+	"slices"
+	"sync"
+)
+
+// sliceStorage is a concurrency-safe slice implementing storage.
+// The top of the stack is the last element of the slice.
+type sliceStorage[T any] struct {
+	items []T
+	mu    sync.RWMutex
+}
+
+func newSliceStorage[T any](capacity int) *sliceStorage[T] {
+	return &sliceStorage[T]{items: make([]T, 0, max(capacity, 0))}
+}
+
+func (s *sliceStorage[T]) push(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items = append(s.items, value)
+}
+
+func (s *sliceStorage[T]) pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var empty T
+	last := len(s.items) - 1
+	if last < 0 {
+		return empty, false
+	}
+	value := s.items[last]
+	s.items[last] = empty // release the reference for the GC
+	s.items = s.items[:last]
+	return value, true
+}
+
+func (s *sliceStorage[T]) top() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var empty T
+	if len(s.items) == 0 {
+		return empty, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+func (s *sliceStorage[T]) len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.items)
+}
+
+func (s *sliceStorage[T]) clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.items)
+	s.items = s.items[:0]
+}
+
+func (s *sliceStorage[T]) shrink() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cap(s.items) > len(s.items) {
+		s.items = slices.Clone(s.items)
+	}
+}
@@ -0,0 +1,146 @@
+package stack
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSliceStack(t *testing.T) {
+	st := NewSliceStack[int](4)
+	assert.True(t, st.IsEmpty())
+	assert.Equal(t, 0, st.Top())
+
+	st = NewSliceStack(0, 1, 2, 3)
+	assert.Equal(t, 3, st.Size())
+	assert.Equal(t, 3, st.Top())
+}
+
+func TestSliceStackLIFO(t *testing.T) {
+	st := NewSliceStack[int](1)
+	for i := range 100 {
+		st.Push(i)
+	}
+	for i := 99; i >= 0; i-- {
+		v, ok := st.TryPop()
+		require.True(t, ok)
+		assert.Equal(t, i, v)
+	}
+	_, ok := st.TryPop()
+	assert.False(t, ok)
+	assert.EqualError(t, st.Pop(), "invalid operation: empty stack")
+}
+
+func TestSliceStackPeekAndClear(t *testing.T) {
+	st := NewSliceStack(0, "a", "")
+	v, ok := st.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "", v)
+
+	st.Clear()
+	assert.True(t, st.IsEmpty())
+	_, ok = st.Peek()
+	assert.False(t, ok)
+
+	st.Push("b")
+	assert.Equal(t, "b", st.Top())
+}
+
+func TestSliceStackShrink(t *testing.T) {
+	st := NewSliceStack[int](0)
+	for i := range 1000 {
+		st.Push(i)
+	}
+	for range 990 {
+		require.NoError(t, st.Pop())
+	}
+
+	store := st.store.(*sliceStorage[int])
+	assert.Greater(t, cap(store.items), 10)
+	st.Shrink()
+	assert.Equal(t, 10, cap(store.items))
+	assert.Equal(t, 10, st.Size())
+	assert.Equal(t, 9, st.Top())
+
+	st.Clear()
+	st.Shrink()
+	assert.Equal(t, 0, cap(store.items))
+	st.Push(1)
+	assert.Equal(t, 1, st.Top())
+
+	// No-op for list-backed stacks
+	ls := NewStack[int]()
+	ls.Push(1)
+	ls.Shrink()
+	assert.Equal(t, 1, ls.Top())
+}
+
+func TestSliceStackConcurrentAccess(t *testing.T) {
+	st := NewSliceStack[int](0)
+	var wg sync.WaitGroup
+	numGoroutines := 20
+	opsPerGoroutine := 1000
+
+	for i := range numGoroutines {
+		wg.Add(1)
+		go func(base int) {
+			defer wg.Done()
+			for j := range opsPerGoroutine {
+				st.Push(base*opsPerGoroutine + j)
+			}
+		}(i)
+	}
+
+	var mu sync.Mutex
+	popped := 0
+	for range numGoroutines / 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range opsPerGoroutine / 2 {
+				if _, ok := st.TryPop(); ok {
+					mu.Lock()
+					popped++
+					mu.Unlock()
+				}
+				_ = st.Top()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, numGoroutines*opsPerGoroutine-popped, st.Size())
+}
+
+func benchmarkStack(b *testing.B, newStack func() *Stack[int]) {
+	b.Run("PushPop", func(b *testing.B) {
+		st := newStack()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			st.Push(i)
+			_, _ = st.TryPop()
+		}
+	})
+	b.Run("Burst1024", func(b *testing.B) {
+		st := newStack()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := range 1024 {
+				st.Push(j)
+			}
+			for range 1024 {
+				_, _ = st.TryPop()
+			}
+		}
+	})
+}
+
+func BenchmarkListStack(b *testing.B) {
+	benchmarkStack(b, NewStack[int])
+}
+
+func BenchmarkSliceStack(b *testing.B) {
+	benchmarkStack(b, func() *Stack[int] { return NewSliceStack[int](0) })
+}
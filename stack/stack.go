@@ -8,52 +8,123 @@ import (
 
 // Stack represents a generic stack data structure.
 type Stack[T any] struct {
-	head *list.List[T]
+	store storage[T]
 }
 
-// NewStack creates and returns a new instance of Stack.
+// storage is the LIFO backend behind a Stack. Implementations must be safe
+// for concurrent use and perform each method as a single atomic step.
+type storage[T any] interface {
+	push(value T)
+	pop() (T, bool)
+	top() (T, bool)
+	len() int
+	clear()
+	shrink()
+}
+
+// NewStack creates and returns a new instance of Stack backed by a
+// concurrency-safe doubly linked list.
 func NewStack[T any]() *Stack[T] {
 	return &Stack[T]{
-		head: list.NewList[T](),
+		store: &listStorage[T]{list: list.NewList[T]()},
+	}
+}
+
+// NewSliceStack creates and returns a new instance of Stack backed by a
+// contiguous slice with room for capacity elements before the first
+// reallocation. Growth is amortized; call Shrink to release unused space.
+// The elements are pushed in order, so the last one ends up on top.
+func NewSliceStack[T any](capacity int, elements ...T) *Stack[T] {
+	st := &Stack[T]{
+		store: newSliceStorage[T](max(capacity, len(elements))),
+	}
+	for _, e := range elements {
+		st.Push(e)
 	}
+	return st
 }
 
 // Push adds a new element to the top of the stack.
 func (st *Stack[T]) Push(value T) {
-	st.head.PushFront(value)
+	st.store.push(value)
 }
 
 // IsEmpty returns true if the stack is empty.
 func (st *Stack[T]) IsEmpty() bool {
-	return st.head.Len() < 1
+	return st.store.len() < 1
 }
 
 // Pop removes the top element from the stack.
 // Returns an error if the stack is empty.
 func (st *Stack[T]) Pop() error {
-	if st.IsEmpty() {
+	if _, ok := st.store.pop(); !ok {
 		return errors.New("invalid operation: empty stack")
 	}
-	st.head.PopFront()
 	return nil
 }
 
+// TryPop removes and returns the top element in a single atomic step.
+// The boolean is false if the stack is empty.
+func (st *Stack[T]) TryPop() (T, bool) {
+	return st.store.pop()
+}
+
+// Peek returns the top element without removing it.
+// The boolean is false if the stack is empty.
+func (st *Stack[T]) Peek() (T, bool) {
+	return st.store.top()
+}
+
 // Top returns the top element of the stack without removing it.
 // Returns the zero value of the type if the stack is empty.
 func (st *Stack[T]) Top() T {
-	var empty T
-	if node := st.head.Front(); node != nil {
-		return node.Element()
-	}
-	return empty
+	value, _ := st.store.top()
+	return value
 }
 
 // Size returns the number of elements in the stack.
 func (st *Stack[T]) Size() int {
-	return st.head.Len()
+	return st.store.len()
 }
 
 // Clear removes all elements from the stack.
 func (st *Stack[T]) Clear() {
-	st.head.Clear()
+	st.store.clear()
 }
+
+// Shrink releases capacity that is no longer in use. It is a no-op for
+// list-backed stacks, which never hold spare capacity.
+func (st *Stack[T]) Shrink() {
+	st.store.shrink()
+}
+
+// listStorage adapts list.List to the storage interface.
+type listStorage[T any] struct {
+	list *list.List[T]
+}
+
+func (s *listStorage[T]) push(value T) {
+	s.list.PushFront(value)
+}
+
+func (s *listStorage[T]) pop() (T, bool) {
+	return s.list.PopFront()
+}
+
+func (s *listStorage[T]) top() (T, bool) {
+	var empty T
+	if node := s.list.Front(); node != nil {
+		return node.Element(), true
+	}
+	return empty, false
+}
+
+func (s *listStorage[T]) len() int {
+	return s.list.Len()
+}
+
+func (s *listStorage[T]) clear() {
+	s.list.Clear()
+}
+
+func (s *listStorage[T]) shrink() {}
@@ -77,6 +77,43 @@ func TestTop(t *testing.T) {
 	assert.Equal(t, 1100000000001, st.Top()) // Top element without removing
 }
 
+func TestTryPop(t *testing.T) {
+	stack := NewStack[int]()
+	v, ok := stack.TryPop()
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+
+	stack.Push(0)
+	stack.Push(7)
+	v, ok = stack.TryPop()
+	assert.True(t, ok)
+	assert.Equal(t, 7, v)
+
+	// A zero value on the stack is distinguishable from empty
+	v, ok = stack.TryPop()
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+	assert.True(t, stack.IsEmpty())
+}
+
+func TestPeek(t *testing.T) {
+	stack := NewStack[string]()
+	v, ok := stack.Peek()
+	assert.False(t, ok)
+	assert.Equal(t, "", v)
+
+	stack.Push("")
+	v, ok = stack.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "", v)
+
+	stack.Push("top")
+	v, ok = stack.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "top", v)
+	assert.Equal(t, 2, stack.Size())
+}
+
 func TestGenericStack(t *testing.T) {
 	type customType struct {
 		id   int
@@ -124,6 +161,17 @@ func TestStackConcurrentAccess(t *testing.T) {
 		}()
 	}
 
+	// Concurrent TryPop
+	for i := 0; i < numGoroutines/2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < opsPerGoroutine/2; j++ {
+				_, _ = stack.TryPop()
+			}
+		}()
+	}
+
 	// Concurrent Top/Size/IsEmpty
 	for i := 0; i < numGoroutines; i++ {
 		wg.Add(1)